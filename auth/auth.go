@@ -0,0 +1,177 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/osamikoyo/yoconf/config"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	PermissionSecretRead = "secret:read"
+	PermissionApprove    = "config:approve"
+	PermissionBreakGlass = "config:break-glass"
+	PermissionAdmin      = "config:admin"
+	PermissionAll        = "*"
+)
+
+var ErrUnauthenticated = errors.New("unknown token")
+
+// Principal is the caller behind a request. Requests without a token are
+// served as the anonymous principal, which holds no permissions.
+// Authenticated is set only on principals that presented a token.
+type Principal struct {
+	Name          string
+	Permissions   []string
+	Authenticated bool
+}
+
+var Anonymous = &Principal{Name: "anonymous"}
+
+func (p *Principal) Can(permission string) bool {
+	if p == nil {
+		return false
+	}
+
+	return slices.Contains(p.Permissions, permission) || slices.Contains(p.Permissions, PermissionAll)
+}
+
+// Is reports whether p is the authenticated principal called name. Anonymous
+// callers, claimed authors and background jobs are nobody.
+func (p *Principal) Is(name string) bool {
+	return p != nil && p.Authenticated && p.Name == name
+}
+
+type principalKey struct{}
+
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+func FromContext(ctx context.Context) *Principal {
+	if principal, ok := ctx.Value(principalKey{}).(*Principal); ok {
+		return principal
+	}
+
+	return Anonymous
+}
+
+// Claimed returns principal, unless it is anonymous and the request names an
+// author, in which case it returns an unauthenticated principal without
+// permissions under that name. The name is only good for attribution.
+func Claimed(principal *Principal, author string) *Principal {
+	if principal != Anonymous || author == "" {
+		return principal
+	}
+
+	return &Principal{Name: author}
+}
+
+type Authenticator struct {
+	tokens map[string]*Principal
+}
+
+func NewAuthenticator(tokens []config.Token) *Authenticator {
+	a := &Authenticator{
+		tokens: make(map[string]*Principal, len(tokens)),
+	}
+
+	for _, token := range tokens {
+		a.tokens[token.Token] = &Principal{
+			Name:          token.Principal,
+			Permissions:   token.Permissions,
+			Authenticated: true,
+		}
+	}
+
+	return a
+}
+
+// Authenticate maps a bearer token to its principal. An empty token is anonymous.
+func (a *Authenticator) Authenticate(header string) (*Principal, error) {
+	token := strings.TrimSpace(header)
+	if scheme, rest, ok := strings.Cut(token, " "); ok && strings.EqualFold(scheme, "bearer") {
+		token = strings.TrimSpace(rest)
+	}
+
+	if token == "" {
+		return Anonymous, nil
+	}
+
+	principal, ok := a.tokens[token]
+	if !ok {
+		return nil, ErrUnauthenticated
+	}
+
+	return principal, nil
+}
+
+func (a *Authenticator) Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			principal, err := a.Authenticate(c.Request().Header.Get(echo.HeaderAuthorization))
+			if err != nil {
+				return c.String(http.StatusUnauthorized, err.Error())
+			}
+
+			c.SetRequest(c.Request().WithContext(WithPrincipal(c.Request().Context(), principal)))
+
+			return next(c)
+		}
+	}
+}
+
+func (a *Authenticator) principal(ctx context.Context) (*Principal, error) {
+	header := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("authorization"); len(values) > 0 {
+			header = values[0]
+		}
+	}
+
+	principal, err := a.Authenticate(header)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	return principal, nil
+}
+
+func (a *Authenticator) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		principal, err := a.principal(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(WithPrincipal(ctx, principal), req)
+	}
+}
+
+type principalStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *principalStream) Context() context.Context {
+	return s.ctx
+}
+
+func (a *Authenticator) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		principal, err := a.principal(ss.Context())
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &principalStream{ServerStream: ss, ctx: WithPrincipal(ss.Context(), principal)})
+	}
+}
@@ -0,0 +1,50 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/osamikoyo/yoconf/config"
+)
+
+func TestIs(t *testing.T) {
+	a := NewAuthenticator([]config.Token{{Token: "t", Principal: "alice"}})
+
+	alice, err := a.Authenticate("Bearer t")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		principal *Principal
+		want      bool
+	}{
+		{"token", alice, true},
+		{"claimed", Claimed(Anonymous, "alice"), false},
+		{"anonymous", Anonymous, false},
+		{"job", &Principal{Name: "alice"}, false},
+		{"nil", nil, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.principal.Is("alice"); got != tt.want {
+			t.Errorf("%s: Is(alice) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestClaimed(t *testing.T) {
+	if got := Claimed(Anonymous, ""); got != Anonymous {
+		t.Errorf("Claimed without author = %v, want anonymous", got)
+	}
+
+	alice := &Principal{Name: "alice", Authenticated: true}
+	if got := Claimed(alice, "bob"); got != alice {
+		t.Errorf("Claimed(alice, bob) = %v, want alice", got)
+	}
+
+	claimed := Claimed(Anonymous, "bob")
+	if claimed.Name != "bob" || claimed.Authenticated || len(claimed.Permissions) != 0 {
+		t.Errorf("Claimed(anonymous, bob) = %+v", claimed)
+	}
+}
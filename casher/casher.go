@@ -3,35 +3,60 @@ package casher
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/osamikoyo/yoconf/envelope"
 	"github.com/osamikoyo/yoconf/logger"
 	"github.com/osamikoyo/yoconf/models"
+	"github.com/osamikoyo/yoconf/secrets"
 	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
 )
 
 type Casher struct {
-	client *redis.Client
-	logger *logger.Logger
+	client  *redis.Client
+	logger  *logger.Logger
+	crypter *envelope.Crypter
 }
 
 var ExpTime = 2 * time.Hour
 
-func NewCasher(client *redis.Client, logger *logger.Logger) *Casher {
+// KeyPrefix namespaces the keys of cached chunks, so that a shared Redis
+// database can hold other data next to them.
+const KeyPrefix = "yoconf:chunk:"
+
+func NewCasher(client *redis.Client, logger *logger.Logger, crypter *envelope.Crypter) *Casher {
 	return &Casher{
-		client: client,
-		logger: logger,
+		client:  client,
+		logger:  logger,
+		crypter: crypter,
 	}
 }
 
+func Key(project, environment string) string {
+	return fmt.Sprintf("%s%s/%s", KeyPrefix, project, environment)
+}
+
 func (c *Casher) Close() error {
 	return c.client.Close()
 }
 
 func (c *Casher) CreateChunk(ctx context.Context, chunk *models.Chunk) error {
-	data, err := json.Marshal(chunk)
+	sealed, err := c.crypter.Encrypt(chunk.Data, envelope.Bind(chunk.Project, chunk.Environment, chunk.Version))
+	if err != nil {
+		c.logger.Error("failed encrypt chunk",
+			zap.Any("chunk", chunk),
+			zap.Error(err))
+
+		return fmt.Errorf("failed encrypt chunk: %v", err)
+	}
+
+	record := *chunk
+	record.Data = sealed
+
+	data, err := json.Marshal(&record)
 	if err != nil {
 		c.logger.Error("failed marshal chunk",
 			zap.Any("chunk", chunk),
@@ -39,10 +64,12 @@ func (c *Casher) CreateChunk(ctx context.Context, chunk *models.Chunk) error {
 
 		return fmt.Errorf("failed marshal chunk: %v", err)
 	}
-	_, err = c.client.Set(ctx, chunk.Project, string(data), ExpTime).Result()
+	key := Key(chunk.Project, chunk.Environment)
+
+	_, err = c.client.Set(ctx, key, string(data), ExpTime).Result()
 	if err != nil {
 		c.logger.Error("failed set",
-			zap.String("key", chunk.Project),
+			zap.String("key", key),
 			zap.Error(err))
 
 		return fmt.Errorf("failed set: %v", err)
@@ -54,43 +81,113 @@ func (c *Casher) CreateChunk(ctx context.Context, chunk *models.Chunk) error {
 	return nil
 }
 
-func (c *Casher) GetData(ctx context.Context, project string) (string, error) {
-	data, err := c.client.Get(ctx, project).Result()
+func (c *Casher) GetChunk(ctx context.Context, project, environment string) (*models.Chunk, error) {
+	key := Key(project, environment)
+
+	data, err := c.client.Get(ctx, key).Result()
 	if err != nil {
 		c.logger.Error("failed get data",
-			zap.String("key", project),
+			zap.String("key", key),
 			zap.Error(err))
 
-		return "", err
+		return nil, err
 	}
 
 	chunk := models.Chunk{}
 	if err = json.Unmarshal([]byte(data), &chunk); err != nil {
 		c.logger.Error("failed unmarshal data",
-			zap.String("data", data),
+			zap.String("data", secrets.Mask(data)),
+			zap.Error(err))
+
+		return nil, err
+	}
+
+	if chunk.Data, err = c.crypter.Decrypt(chunk.Data, envelope.Bind(project, environment, chunk.Version)); err != nil {
+		c.logger.Error("failed decrypt chunk",
+			zap.String("key", key),
 			zap.Error(err))
 
-		return "", err
+		return nil, fmt.Errorf("failed decrypt chunk: %v", err)
 	}
 
 	c.logger.Info("successfully fetch data",
-		zap.String("key", project))
+		zap.String("key", key))
 
-	return data, nil
+	return &chunk, nil
 }
 
-func (c *Casher) DeleteChunk(ctx context.Context, project string) error {
-	_, err := c.client.Del(ctx, project).Result()
+func (c *Casher) DeleteChunk(ctx context.Context, project, environment string) error {
+	key := Key(project, environment)
+
+	_, err := c.client.Del(ctx, key).Result()
 	if err != nil {
 		c.logger.Error("failed delete",
-			zap.String("key", project),
+			zap.String("key", key),
 			zap.Error(err))
 
 		return fmt.Errorf("failed delete: %v", err)
 	}
 
 	c.logger.Info("successfully delete chunk",
-		zap.String("key", project))
+		zap.String("key", key))
 
 	return nil
 }
+
+// Rewrap moves every cached chunk under the current master key, keeping
+// the remaining time to live of each entry. Keys outside KeyPrefix and values
+// that are not chunks cached under their own key are left alone.
+func (c *Casher) Rewrap(ctx context.Context) (int, error) {
+	rewritten := 0
+
+	iter := c.client.Scan(ctx, 0, KeyPrefix+"*", 0).Iterator()
+	for iter.Next(ctx) {
+		key := iter.Val()
+
+		data, err := c.client.Get(ctx, key).Result()
+		if err != nil {
+			if errors.Is(err, redis.Nil) {
+				continue
+			}
+
+			return rewritten, fmt.Errorf("failed get: %v", err)
+		}
+
+		chunk := models.Chunk{}
+		if err = json.Unmarshal([]byte(data), &chunk); err != nil || chunk.Project == "" || Key(chunk.Project, chunk.Environment) != key {
+			continue
+		}
+
+		sealed, changed, err := c.crypter.Rewrap(chunk.Data, envelope.Bind(chunk.Project, chunk.Environment, chunk.Version))
+		if err != nil {
+			c.logger.Error("failed rewrap chunk",
+				zap.String("key", key),
+				zap.Error(err))
+
+			return rewritten, fmt.Errorf("failed rewrap chunk: %v", err)
+		}
+
+		if !changed {
+			continue
+		}
+
+		chunk.Data = sealed
+
+		raw, err := json.Marshal(&chunk)
+		if err != nil {
+			return rewritten, fmt.Errorf("failed marshal chunk: %v", err)
+		}
+
+		if err = c.client.SetArgs(ctx, key, string(raw), redis.SetArgs{KeepTTL: true, Mode: "XX"}).Err(); err != nil && !errors.Is(err, redis.Nil) {
+			return rewritten, fmt.Errorf("failed set: %v", err)
+		}
+
+		rewritten++
+	}
+
+	if err := iter.Err(); err != nil {
+		return rewritten, fmt.Errorf("failed scan: %v", err)
+	}
+
+	return rewritten, nil
+}
@@ -0,0 +1,182 @@
+// Package client reads configs from a yoconf server over HTTP and can verify
+// the signature of every config it loads.
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/osamikoyo/yoconf/models"
+	"github.com/osamikoyo/yoconf/signing"
+)
+
+type Client struct {
+	baseURL  string
+	token    string
+	clientID string
+	labels   map[string]string
+	http     *http.Client
+
+	mu     sync.Mutex
+	keys   []models.PublicKey
+	pinned bool
+}
+
+type Option func(*Client)
+
+func WithToken(token string) Option {
+	return func(c *Client) {
+		c.token = token
+	}
+}
+
+// WithClientID sends a stable client id, which places the client in
+// percentage rollouts.
+func WithClientID(id string) Option {
+	return func(c *Client) {
+		c.clientID = id
+	}
+}
+
+// WithLabels sends client labels used by targeting rules.
+func WithLabels(labels map[string]string) Option {
+	return func(c *Client) {
+		c.labels = labels
+	}
+}
+
+func WithHTTPClient(client *http.Client) Option {
+	return func(c *Client) {
+		c.http = client
+	}
+}
+
+// WithPublicKeys pins the keys used for verification instead of fetching them
+// from the server.
+func WithPublicKeys(keys []models.PublicKey) Option {
+	return func(c *Client) {
+		c.keys = keys
+		c.pinned = true
+	}
+}
+
+func New(baseURL string, opts ...Option) *Client {
+	c := &Client{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		http:    http.DefaultClient,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+func (c *Client) get(ctx context.Context, path string, query url.Values, out any) error {
+	target := c.baseURL + path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	if c.clientID != "" {
+		req.Header.Set("X-Client-ID", c.clientID)
+	}
+	if len(c.labels) > 0 {
+		pairs := make([]string, 0, len(c.labels))
+		for key, value := range c.labels {
+			pairs = append(pairs, key+"="+value)
+		}
+
+		sort.Strings(pairs)
+		req.Header.Set("X-Client-Labels", strings.Join(pairs, ","))
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+
+		return fmt.Errorf("yoconf: %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+func (c *Client) Get(ctx context.Context, project, environment string) (*models.Chunk, error) {
+	query := url.Values{}
+	if environment != "" {
+		query.Set("env", environment)
+	}
+
+	chunk := &models.Chunk{}
+	if err := c.get(ctx, "/get/"+url.PathEscape(project), query, chunk); err != nil {
+		return nil, err
+	}
+
+	return chunk, nil
+}
+
+func (c *Client) SigningKeys(ctx context.Context) ([]models.PublicKey, error) {
+	keys := []models.PublicKey{}
+	if err := c.get(ctx, "/keys/signing", nil, &keys); err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+// Verify checks the signature of chunk. Unknown key ids trigger one refresh
+// of the server keys, so that rotations are picked up.
+func (c *Client) Verify(ctx context.Context, chunk *models.Chunk) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	err := signing.Verify(chunk, c.keys)
+	if err == nil || c.pinned || chunk.Signature == "" {
+		return err
+	}
+
+	keys, fetchErr := c.SigningKeys(ctx)
+	if fetchErr != nil {
+		return fetchErr
+	}
+
+	c.keys = keys
+
+	return signing.Verify(chunk, c.keys)
+}
+
+// GetVerified loads a config and fails unless its signature checks out.
+func (c *Client) GetVerified(ctx context.Context, project, environment string) (*models.Chunk, error) {
+	chunk, err := c.Get(ctx, project, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = c.Verify(ctx, chunk); err != nil {
+		return nil, err
+	}
+
+	return chunk, nil
+}
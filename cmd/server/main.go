@@ -9,15 +9,24 @@ import (
 	"time"
 
 	"github.com/labstack/echo/v4"
+	"github.com/osamikoyo/yoconf/auth"
 	"github.com/osamikoyo/yoconf/casher"
 	"github.com/osamikoyo/yoconf/config"
 	"github.com/osamikoyo/yoconf/core"
+	"github.com/osamikoyo/yoconf/envelope"
+	"github.com/osamikoyo/yoconf/events"
 	"github.com/osamikoyo/yoconf/grpcserver"
 	"github.com/osamikoyo/yoconf/handler"
 	"github.com/osamikoyo/yoconf/httpserver"
+	"github.com/osamikoyo/yoconf/keyring"
 	"github.com/osamikoyo/yoconf/logger"
+	"github.com/osamikoyo/yoconf/models"
 	"github.com/osamikoyo/yoconf/pb"
+	"github.com/osamikoyo/yoconf/pb/etcd"
+	"github.com/osamikoyo/yoconf/pb/flagd"
 	"github.com/osamikoyo/yoconf/retrier"
+	"github.com/osamikoyo/yoconf/secrets"
+	"github.com/osamikoyo/yoconf/signing"
 	"github.com/osamikoyo/yoconf/storage"
 	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
@@ -68,6 +77,14 @@ func main() {
 		return
 	}
 
+	if err = DBconn.AutoMigrate(&models.Chunk{}, &models.Project{}, &models.Schema{}, &models.Rule{}, &models.Dependency{}, &models.Rollout{}, &models.Targeting{}, &models.Schedule{}, &models.AuditEntry{}, &models.Override{}, &models.Proposal{}, &models.Lock{}, &models.FreezeWindow{}, &models.Revision{}); err != nil {
+		logger.Fatal("failed migrate db",
+			zap.String("path", cfg.DBPath),
+			zap.Error(err))
+
+		return
+	}
+
 	redisConn, err := retrier.Connect(5, func() (*redis.Client, error) {
 		config := &redis.Options{
 			DB:   0,
@@ -86,17 +103,84 @@ func main() {
 		return
 	}
 
-	casher := casher.NewCasher(redisConn, logger)
-	storage := storage.NewStorage(DBconn, logger, cfg)
+	var masterKeys keyring.KeyProvider
+	if cfg.MasterKeyFile != "" {
+		provider, err := keyring.NewFileProvider(cfg.MasterKeyFile)
+		if err != nil {
+			logger.Fatal("failed load master keyfile",
+				zap.String("path", cfg.MasterKeyFile),
+				zap.Error(err))
+
+			return
+		}
+
+		masterKeys = provider
+	}
+
+	crypter := envelope.NewCrypter(masterKeys)
+
+	casher := casher.NewCasher(redisConn, logger, crypter)
+	storage := storage.NewStorage(DBconn, logger, cfg, crypter)
+
+	bus := events.NewBus(logger)
+
+	var keys keyring.KeyProvider
+	if cfg.KeyFile != "" {
+		provider, err := keyring.NewFileProvider(cfg.KeyFile)
+		if err != nil {
+			logger.Fatal("failed load keyfile",
+				zap.String("path", cfg.KeyFile),
+				zap.Error(err))
+
+			return
+		}
+
+		keys = provider
+	}
+
+	sealer := secrets.NewSealer(keys)
+
+	var signingKeys keyring.KeyProvider
+	if cfg.SigningKeyFile != "" {
+		provider, err := keyring.NewFileProvider(cfg.SigningKeyFile)
+		if err != nil {
+			logger.Fatal("failed load signing keyfile",
+				zap.String("path", cfg.SigningKeyFile),
+				zap.Error(err))
+
+			return
+		}
+
+		signingKeys = provider
+	}
+
+	signer := signing.NewSigner(signingKeys)
+	authenticator := auth.NewAuthenticator(cfg.Tokens)
+
+	core := core.NewCore(casher, storage, bus, sealer, signer, logger, 30*time.Second)
+
+	if crypter.Enabled() {
+		go core.RunRewrap(ctx, cfg.RewrapInterval)
+	}
 
-	core := core.NewCore(casher, storage, logger, 30*time.Second)
+	go core.RunRollouts(ctx, cfg.RolloutInterval)
+	go core.RunSchedules(ctx, cfg.ScheduleInterval)
+	go core.RunOverrides(ctx, cfg.OverrideInterval)
 
-	handler := handler.NewHandler(core)
+	flagdserver := grpcserver.NewFlagdServer(core, bus, cfg.FlagdProject)
+	handler := handler.NewHandler(core, authenticator, flagdserver)
+	etcdserver := grpcserver.NewEtcdServer(core)
 	grpcserver := grpcserver.NewGRPCServer(core)
 	httpserver := httpserver.NewHTTPServer(echo.New(), logger, cfg, handler)
 
-	coreserver := grpc.NewServer()
+	coreserver := grpc.NewServer(
+		grpc.UnaryInterceptor(authenticator.UnaryInterceptor()),
+		grpc.StreamInterceptor(authenticator.StreamInterceptor()),
+	)
 	pb.RegisterYoConfServer(coreserver, grpcserver)
+	flagd.RegisterServiceServer(coreserver, flagdserver)
+	etcd.RegisterKVServer(coreserver, etcdserver)
+	etcd.RegisterWatchServer(coreserver, etcdserver)
 
 	go func() {
 		<-ctx.Done()
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"text/tabwriter"
+	"time"
+
+	"github.com/osamikoyo/yoconf/client"
+	"github.com/osamikoyo/yoconf/config"
+	"github.com/osamikoyo/yoconf/logger"
+	"github.com/osamikoyo/yoconf/storage"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+const usage = `usage: yoconfctl [--config config.yaml] <command>
+
+commands:
+  keys                                 list the master key protecting every stored chunk
+  verify [--url URL] [--token T] project [environment]
+                                       fetch a config and verify its signature
+`
+
+func main() {
+	configPath := flag.String("config", "config.yaml", "path to the server config")
+	flag.Usage = func() {
+		fmt.Fprint(os.Stderr, usage)
+	}
+	flag.Parse()
+
+	if flag.NArg() < 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	var err error
+
+	switch flag.Arg(0) {
+	case "keys":
+		var cfg *config.Config
+		if cfg, err = config.NewConfig(*configPath); err == nil {
+			err = keys(cfg)
+		}
+	case "verify":
+		err = verify(flag.Args()[1:])
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fail(err)
+	}
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "yoconfctl:", err)
+	os.Exit(1)
+}
+
+func openStorage(cfg *config.Config) (*storage.Storage, error) {
+	db, err := gorm.Open(sqlite.Open(cfg.DBPath))
+	if err != nil {
+		return nil, fmt.Errorf("failed connect to db: %v", err)
+	}
+
+	logger.Init(logger.Config{
+		AppName:  "yoconfctl",
+		LogLevel: "error",
+	})
+
+	return storage.NewStorage(db, logger.Get(), cfg, nil), nil
+}
+
+func keys(cfg *config.Config) error {
+	s, err := openStorage(cfg)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	records, err := s.ListRecordKeys(ctx)
+	if err != nil {
+		return err
+	}
+
+	counts := map[string]int{}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "PROJECT\tENVIRONMENT\tVERSION\tKEY")
+
+	for _, record := range records {
+		key := record.KeyID
+		if key == "" {
+			key = "plaintext"
+		}
+
+		counts[key]++
+		fmt.Fprintf(w, "%s\t%s\t%d\t%s\n", record.Project, record.Environment, record.Version, key)
+	}
+
+	fmt.Fprintln(w)
+	for _, key := range slices.Sorted(maps.Keys(counts)) {
+		fmt.Fprintf(w, "%s\t%d records\n", key, counts[key])
+	}
+
+	return w.Flush()
+}
+
+func verify(args []string) error {
+	flags := flag.NewFlagSet("verify", flag.ExitOnError)
+	baseURL := flags.String("url", "http://localhost:8080", "yoconf http address")
+	token := flags.String("token", "", "bearer token")
+	flags.Parse(args)
+
+	if flags.NArg() < 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	c := client.New(*baseURL, client.WithToken(*token))
+
+	chunk, err := c.GetVerified(ctx, flags.Arg(0), flags.Arg(1))
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s/%s v%d: signature ok (key %s)\n", chunk.Project, chunk.Environment, chunk.Version, chunk.SigningKey)
+
+	return nil
+}
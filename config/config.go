@@ -3,16 +3,37 @@ package config
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
 
 type Config struct {
-	HTTPPort int    `yaml:"http_port"`
-	GrpcPort int    `yaml:"grpc_port"`
-	Addr     string `yaml:"addr"`
-	RedisURL string `yaml:"redis_url"`
-	DBPath   string `yaml:"db_path"`
+	HTTPPort int     `yaml:"http_port"`
+	GrpcPort int     `yaml:"grpc_port"`
+	Addr     string  `yaml:"addr"`
+	RedisURL string  `yaml:"redis_url"`
+	DBPath   string  `yaml:"db_path"`
+	KeyFile  string  `yaml:"key_file"`
+	Tokens   []Token `yaml:"tokens"`
+
+	SigningKeyFile string        `yaml:"signing_key_file"`
+	MasterKeyFile  string        `yaml:"master_key_file"`
+	RewrapInterval time.Duration `yaml:"rewrap_interval"`
+
+	RolloutInterval  time.Duration `yaml:"rollout_interval"`
+	ScheduleInterval time.Duration `yaml:"schedule_interval"`
+	OverrideInterval time.Duration `yaml:"override_interval"`
+
+	// FlagdProject is the flag project flagd clients get when they send no
+	// flagd-selector header.
+	FlagdProject string `yaml:"flagd_project"`
+}
+
+type Token struct {
+	Token       string   `yaml:"token"`
+	Principal   string   `yaml:"principal"`
+	Permissions []string `yaml:"permissions"`
 }
 
 func NewConfig(addr string) (*Config, error) {
@@ -0,0 +1,49 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/osamikoyo/yoconf/models"
+	"go.uber.org/zap"
+)
+
+const (
+	AuditPublish        = "publish"
+	AuditActivate       = "activate"
+	AuditDelete         = "delete"
+	AuditSchedule       = "schedule"
+	AuditCancelSchedule = "cancel_schedule"
+	AuditScheduleFailed = "schedule_failed"
+
+	DefaultAuditLimit = 100
+)
+
+// audit appends an entry to the audit trail. The change it describes already
+// happened, so a failure is logged rather than returned.
+func (c *Core) audit(ctx context.Context, entry models.AuditEntry) {
+	entry.Time = time.Now().UTC()
+
+	if err := c.storage.AppendAudit(ctx, &entry); err != nil {
+		c.logger.Error("failed write audit entry",
+			zap.String("project", entry.Project),
+			zap.String("action", entry.Action),
+			zap.String("actor", entry.Actor),
+			zap.Error(err))
+	}
+}
+
+func (c *Core) ListAudit(project string, limit int) ([]models.AuditEntry, error) {
+	if project == "" {
+		return nil, ErrNilInput
+	}
+
+	if limit <= 0 {
+		limit = DefaultAuditLimit
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	return c.storage.ListAudit(ctx, project, limit)
+}
@@ -0,0 +1,216 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/osamikoyo/yoconf/auth"
+	"github.com/osamikoyo/yoconf/keypath"
+	"github.com/osamikoyo/yoconf/models"
+	"github.com/osamikoyo/yoconf/storage"
+)
+
+const (
+	DefaultKVWait = 5 * time.Minute
+	MaxKVWait     = 10 * time.Minute
+)
+
+// KVRequest is a Consul KV read. The first segment of Key names the project
+// and the rest walks into its parsed config, list items by index. Index and
+// Wait make it a blocking query that returns once the environment changed
+// after revision Index, or after Wait.
+type KVRequest struct {
+	Key         string
+	Environment string
+	Recurse     bool
+	Index       int64
+	Wait        time.Duration
+	Principal   *auth.Principal
+}
+
+// ReadKV returns the pairs under a key along with the revision of the global
+// change sequence they were read at, which stands in for the Consul index.
+// Unlike versions it never goes backwards, not even on a roll back.
+func (c *Core) ReadKV(ctx context.Context, req KVRequest) ([]models.KVPair, int64, error) {
+	key := strings.Trim(req.Key, "/")
+
+	project, path, _ := strings.Cut(key, "/")
+	if project == "" {
+		return nil, 0, ErrNilInput
+	}
+
+	environment := models.EnvironmentOrDefault(req.Environment)
+
+	if req.Index > 0 {
+		c.waitRevision(ctx, project, environment, req.Index, req.Wait)
+	}
+
+	// The revision is taken before the read, so a change racing it shows
+	// up in the next blocking query rather than going unnoticed.
+	index, create, modify, err := c.kvIndexes(ctx, project, environment)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	chunk, err := c.GetConfig(ReadRequest{
+		Project:     project,
+		Environment: environment,
+		Principal:   req.Principal,
+	})
+	if err != nil {
+		return nil, index, err
+	}
+
+	value, err := parseData(chunk)
+	if err != nil {
+		return nil, index, err
+	}
+
+	segments := []string{}
+	if path != "" {
+		segments = strings.Split(path, "/")
+	}
+
+	if value, err = keypath.GetSegments(value, segments); err != nil {
+		return nil, index, err
+	}
+
+	pairs := []models.KVPair{}
+	add := func(key string, value any) error {
+		data, err := kvValue(value)
+		if err != nil {
+			return err
+		}
+
+		pairs = append(pairs, models.KVPair{
+			Key:         key,
+			Value:       data,
+			CreateIndex: uint64(create),
+			ModifyIndex: uint64(modify),
+		})
+
+		return nil
+	}
+
+	if req.Recurse {
+		err = walkKV(key, value, add)
+	} else {
+		err = add(key, value)
+	}
+	if err != nil {
+		return nil, index, err
+	}
+
+	sort.Slice(pairs, func(i, j int) bool {
+		return pairs[i].Key < pairs[j].Key
+	})
+
+	return pairs, index, nil
+}
+
+// kvIndexes returns the current revision along with the revisions at which
+// an environment was first and last changed. Environments that have not
+// changed since revisions were first recorded use the current one for both.
+func (c *Core) kvIndexes(ctx context.Context, project, environment string) (int64, int64, int64, error) {
+	current, err := c.storage.CurrentRevision(ctx)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	first, err := c.storage.FirstRevision(ctx, project, environment)
+	if errors.Is(err, storage.ErrNotFound) {
+		return current, current, current, nil
+	}
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	last, err := c.storage.RevisionAt(ctx, project, environment, current)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	return current, first.ID, last.ID, nil
+}
+
+// walkKV calls add for every leaf under value, naming it by its path below
+// prefix.
+func walkKV(prefix string, value any, add func(key string, value any) error) error {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, val := range v {
+			if err := walkKV(prefix+"/"+key, val, add); err != nil {
+				return err
+			}
+		}
+	case []any:
+		for i, val := range v {
+			if err := walkKV(prefix+"/"+strconv.Itoa(i), val, add); err != nil {
+				return err
+			}
+		}
+	default:
+		return add(prefix, value)
+	}
+
+	return nil
+}
+
+// kvValue renders a value as the bytes stored under a key: strings as they
+// are, other scalars in their usual text form and subtrees as JSON.
+func kvValue(value any) ([]byte, error) {
+	switch v := value.(type) {
+	case nil:
+		return []byte{}, nil
+	case string:
+		return []byte(v), nil
+	default:
+		return json.Marshal(v)
+	}
+}
+
+// waitRevision blocks until an environment changed after revision index, or
+// anything it is served with did, for at most wait.
+func (c *Core) waitRevision(ctx context.Context, project, environment string, index int64, wait time.Duration) {
+	if wait <= 0 {
+		wait = DefaultKVWait
+	}
+
+	wait = min(wait, MaxKVWait)
+
+	ctx, cancel := context.WithTimeout(ctx, wait)
+	defer cancel()
+
+	ch, unsubscribe := c.bus.Subscribe(16)
+	defer unsubscribe()
+
+	ticker := time.NewTicker(WatchResync)
+	defer ticker.Stop()
+
+	for {
+		_, err := c.storage.RevisionAfter(ctx, project, environment, index)
+		if !errors.Is(err, storage.ErrNotFound) {
+			return
+		}
+
+		// Events of the environment also cover changes to its layers and
+		// includes, which are not revisions of its own.
+		for waiting := true; waiting; {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				waiting = false
+			case event, ok := <-ch:
+				if !ok || event.Project == project && event.Environment == environment {
+					return
+				}
+			}
+		}
+	}
+}
@@ -2,39 +2,57 @@ package core
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
+	"sync"
 	"time"
 
+	"github.com/osamikoyo/yoconf/auth"
 	"github.com/osamikoyo/yoconf/casher"
+	"github.com/osamikoyo/yoconf/events"
 	"github.com/osamikoyo/yoconf/logger"
 	"github.com/osamikoyo/yoconf/models"
-	"github.com/osamikoyo/yoconf/retrier"
+	"github.com/osamikoyo/yoconf/secrets"
+	"github.com/osamikoyo/yoconf/signing"
 	"github.com/osamikoyo/yoconf/storage"
 	"go.uber.org/zap"
 )
 
 const RetrierCount = 5
 
-var ErrNilInput = errors.New("nil input")
+var (
+	ErrNilInput       = errors.New("nil input")
+	ErrInvalidVersion = errors.New("invalid version")
+)
 
 type Core struct {
 	casher  *casher.Casher
 	storage *storage.Storage
+	bus     *events.Bus
+	sealer  *secrets.Sealer
+	signer  *signing.Signer
 	logger  *logger.Logger
 
 	timeout time.Duration
+
+	// flagSets caches parsed flags by "project/environment".
+	flagSets sync.Map
 }
 
 func NewCore(
 	casher *casher.Casher,
 	storage *storage.Storage,
+	bus *events.Bus,
+	sealer *secrets.Sealer,
+	signer *signing.Signer,
 	logger *logger.Logger,
 	timeout time.Duration,
 ) *Core {
 	return &Core{
 		casher:  casher,
 		storage: storage,
+		bus:     bus,
+		sealer:  sealer,
+		signer:  signer,
 		logger:  logger,
 		timeout: timeout,
 	}
@@ -48,73 +66,198 @@ func (c *Core) context() (context.Context, context.CancelFunc) {
 	return context.WithTimeout(context.Background(), c.timeout)
 }
 
-func (c *Core) NewConfig(chunk *models.Chunk) error {
+// NewConfig publishes chunk as the new active version, provided the current
+// active version still satisfies pre.
+func (c *Core) NewConfig(chunk *models.Chunk, actor *auth.Principal, pre models.Precondition) error {
 	if chunk == nil {
 		return ErrNilInput
 	}
 
+	chunk.Environment = models.EnvironmentOrDefault(chunk.Environment)
+	if chunk.Author == "" && actor != nil && actor != auth.Anonymous {
+		chunk.Author = actor.Name
+	}
+
 	ctx, cancel := c.context()
 	defer cancel()
 
-	err := retrier.Try(RetrierCount, func() error {
-		return c.storage.CreateNewChunk(ctx, chunk)
-	})
+	if err := c.checkChange(ctx, chunk.Project, chunk.Environment, chunk.Version, AuditPublish, actor); err != nil {
+		c.logger.Error("failed create chunk",
+			zap.String("project", chunk.Project),
+			zap.String("environment", chunk.Environment),
+			zap.Error(err))
+
+		return err
+	}
+
+	if _, err := c.validate(ctx, chunk); err != nil {
+		c.logger.Error("failed validate chunk",
+			zap.String("project", chunk.Project),
+			zap.Error(err))
+
+		return err
+	}
+
+	if err := c.checkPrecondition(ctx, chunk.Project, chunk.Environment, pre); err != nil {
+		c.logger.Error("failed create chunk",
+			zap.String("project", chunk.Project),
+			zap.String("environment", chunk.Environment),
+			zap.Error(err))
+
+		return err
+	}
+
+	// Signatures and hashes cover the data as revealed to readers, not its
+	// sealed form.
+	signed := *chunk
+	signed.Data = secrets.Plain(chunk.Data)
+	chunk.Hash = models.ContentHash(signed.Data)
+
+	if err := c.signer.Sign(&signed); err != nil {
+		c.logger.Error("failed sign chunk",
+			zap.String("project", chunk.Project),
+			zap.Error(err))
+
+		return err
+	}
+
+	chunk.Signature, chunk.SigningKey = signed.Signature, signed.SigningKey
+
+	data, err := c.sealer.Seal(chunk.Data)
 	if err != nil {
-		c.logger.Error("failed create chunk", zap.Error(err))
+		c.logger.Error("failed seal secrets",
+			zap.String("project", chunk.Project),
+			zap.Error(err))
+
+		return err
+	}
 
+	chunk.Data = data
+
+	project, err := c.project(ctx, chunk.Project)
+	if err != nil {
 		return err
 	}
 
-	err = retrier.Try(RetrierCount, func() error {
-		return c.casher.CreateChunk(ctx, chunk)
+	// Changes to protected projects wait for review instead of going live.
+	if project.Protected {
+		return c.propose(ctx, project, chunk, actor)
+	}
+
+	err = write(func() error {
+		return c.storage.CreateNewChunk(ctx, chunk, pre)
 	})
 	if err != nil {
-		c.logger.Error("failed create chunk in cash", zap.Error(err))
+		c.logger.Error("failed create chunk", zap.Error(err))
 
 		return err
 	}
 
+	// A version published ahead of its activation serves nobody yet.
+	if chunk.InUse {
+		if err = c.trackDependencies(ctx, chunk); err != nil {
+			c.logger.Error("failed track dependencies", zap.Error(err))
+
+			return err
+		}
+
+		if err = c.invalidate(ctx, project, chunk.Environment); err != nil {
+			c.logger.Error("failed create chunk in cash", zap.Error(err))
+
+			return err
+		}
+	}
+
+	c.audit(ctx, models.AuditEntry{
+		Actor:       chunk.Author,
+		Action:      AuditPublish,
+		Project:     chunk.Project,
+		Environment: chunk.Environment,
+		Version:     chunk.Version,
+	})
+
+	c.bus.Publish(events.Event{
+		Type:        events.Published,
+		Project:     chunk.Project,
+		Environment: chunk.Environment,
+		Version:     chunk.Version,
+		Actor:       chunk.Author,
+	})
+
 	return nil
 }
 
-func (c *Core) RollOn(project string, version int) error {
+// RollOn activates an existing version, provided the current active version
+// still satisfies pre.
+func (c *Core) RollOn(project, environment string, version int, actor *auth.Principal, pre models.Precondition) error {
 	if project == "" || version < 1 {
 		return ErrNilInput
 	}
 
+	actor = principal(actor)
+
+	environment = models.EnvironmentOrDefault(environment)
+
+	return c.activate(project, environment, version, actor, pre, func(ctx context.Context) error {
+		return c.storage.RollChunkOn(ctx, project, environment, version, pre)
+	})
+}
+
+// activate runs the checks of an activation, stores it with roll and then
+// refreshes the caches.
+func (c *Core) activate(project, environment string, version int, actor *auth.Principal, pre models.Precondition, roll func(ctx context.Context) error) error {
 	ctx, cancel := c.context()
 	defer cancel()
 
-	err := retrier.Try(RetrierCount, func() error {
-		return c.storage.RollChunkOn(ctx, project, version)
-	})
-	if err != nil {
-		c.logger.Error("failed roll chunk on", zap.Error(err))
+	if err := c.checkChange(ctx, project, environment, version, AuditActivate, actor); err != nil {
+		c.logger.Error("failed roll chunk on",
+			zap.String("project", project),
+			zap.String("environment", environment),
+			zap.Int("version", version),
+			zap.Error(err))
 
 		return err
 	}
 
-	data, err := c.casher.GetData(ctx, project)
+	chunk, err := c.storage.GetChunkByVersion(ctx, project, environment, version)
 	if err != nil {
 		c.logger.Error("failed roll chunk on", zap.Error(err))
+
+		return err
 	}
 
-	err = retrier.Try(RetrierCount, func() error {
-		return c.casher.DeleteChunk(ctx, project)
-	})
-	if err != nil {
-		c.logger.Error("failed roll chunk on", zap.Error(err))
+	if err = c.checkApproval(ctx, chunk); err != nil {
+		c.logger.Error("failed roll chunk on",
+			zap.String("project", project),
+			zap.String("environment", environment),
+			zap.Int("version", version),
+			zap.Error(err))
+
+		return err
+	}
+
+	if _, err = c.checkRules(ctx, chunk, ActionRollOn); err != nil {
+		c.logger.Error("failed roll chunk on",
+			zap.String("project", project),
+			zap.String("environment", environment),
+			zap.Int("version", version),
+			zap.Error(err))
+
+		return err
+	}
+
+	if err = c.checkPrecondition(ctx, project, environment, pre); err != nil {
+		c.logger.Error("failed roll chunk on",
+			zap.String("project", project),
+			zap.String("environment", environment),
+			zap.Int("version", version),
+			zap.Error(err))
 
 		return err
 	}
 
-	err = retrier.Try(RetrierCount, func() error {
-		return c.storage.CreateNewChunk(ctx, &models.Chunk{
-			Data:    data,
-			Version: version,
-			Project: project,
-			InUse:   false,
-		})
+	err = write(func() error {
+		return roll(ctx)
 	})
 	if err != nil {
 		c.logger.Error("failed roll chunk on", zap.Error(err))
@@ -122,43 +265,207 @@ func (c *Core) RollOn(project string, version int) error {
 		return err
 	}
 
+	// The version is live from here on, so the caller is not told it failed
+	// when the bookkeeping after it does.
+	if err = c.trackDependencies(ctx, chunk); err != nil {
+		c.logger.Error("failed track dependencies", zap.Error(err))
+	}
+
+	settings, err := c.project(ctx, project)
+	if err == nil {
+		err = c.invalidate(ctx, settings, environment)
+	}
+
+	if err != nil {
+		c.logger.Error("failed invalidate activated config",
+			zap.String("project", project),
+			zap.String("environment", environment),
+			zap.Int("version", version),
+			zap.Error(err))
+	}
+
+	c.audit(ctx, models.AuditEntry{
+		Actor:       actor.Name,
+		Action:      AuditActivate,
+		Project:     project,
+		Environment: environment,
+		Version:     version,
+	})
+
+	c.bus.Publish(events.Event{
+		Type:        events.Activated,
+		Project:     project,
+		Environment: environment,
+		Version:     version,
+		Actor:       actor.Name,
+	})
+
 	return nil
 }
 
-func (c *Core) GetConfig(project string) (*models.Chunk, error) {
+// ReadRequest describes a config read. Labels select targeted versions,
+// ClientID places the client in percentage rollouts and Principal decides
+// whether secrets are revealed.
+type ReadRequest struct {
+	Project     string
+	Environment string
+	ClientID    string
+	Labels      map[string]string
+	Principal   *auth.Principal
+}
+
+func (c *Core) GetConfig(req ReadRequest) (*models.Chunk, error) {
+	project := req.Project
+	environment := models.EnvironmentOrDefault(req.Environment)
+
 	ctx, cancel := c.context()
 	defer cancel()
 
-	data, err := c.casher.GetData(ctx, project)
-	if err == nil {
-		c.logger.Info("successfully fetched config", zap.String("data", data))
+	if len(req.Labels) > 0 {
+		chunk, err := c.targetedChunk(ctx, project, environment, req.Labels)
+		if err != nil {
+			return nil, err
+		}
 
-		chunk := models.Chunk{}
-		if err = json.Unmarshal([]byte(data), &chunk); err != nil {
+		if chunk != nil {
+			return c.present(chunk, req.Principal)
+		}
+	}
+
+	if req.ClientID != "" {
+		chunk, err := c.rolloutChunk(ctx, project, environment, req.ClientID)
+		if err != nil {
 			return nil, err
 		}
 
-		return &chunk, nil
+		if chunk != nil {
+			return c.present(chunk, req.Principal)
+		}
+	}
+
+	if chunk, err := c.casher.GetChunk(ctx, project, environment); err == nil {
+		c.logger.Info("successfully fetched config", zap.Any("chunk", chunk))
+
+		return c.present(chunk, req.Principal)
 	}
 
-	chunk, err := c.storage.GetChunk(ctx, project)
+	chunk, err := c.storage.GetChunk(ctx, project, environment)
 	if err != nil {
 		c.logger.Error("failed get config", zap.Error(err))
+
+		return nil, err
+	}
+
+	if chunk, err = c.build(ctx, chunk); err != nil {
+		return nil, err
+	}
+
+	if err = c.casher.CreateChunk(ctx, chunk); err != nil {
+		c.logger.Warn("failed cache config", zap.Error(err))
 	}
 
 	c.logger.Info("successfully fetched config", zap.Any("chunk", chunk))
-	return chunk, nil
+	return c.present(chunk, req.Principal)
+}
+
+// build turns a stored version into the config served for it by merging its
+// layers and resolving its includes.
+func (c *Core) build(ctx context.Context, chunk *models.Chunk) (*models.Chunk, error) {
+	settings, err := c.project(ctx, chunk.Project)
+	if err != nil {
+		return nil, err
+	}
+
+	merged, err := c.mergeLayers(ctx, settings, chunk)
+	if err != nil {
+		c.logger.Error("failed merge layers",
+			zap.String("project", chunk.Project),
+			zap.String("environment", chunk.Environment),
+			zap.Error(err))
+
+		return nil, err
+	}
+
+	resolved, err := c.resolveIncludes(ctx, merged)
+	if err != nil {
+		c.logger.Error("failed resolve includes",
+			zap.String("project", chunk.Project),
+			zap.String("environment", chunk.Environment),
+			zap.Error(err))
+
+		return nil, err
+	}
+
+	return resolved, nil
 }
 
-func (c *Core) DeleteChunk(project string, version int) error {
+func (c *Core) SigningKeys() ([]models.PublicKey, error) {
+	return c.signer.PublicKeys()
+}
+
+func (c *Core) DeleteChunk(project, environment string, version int, actor *auth.Principal) error {
+	actor = principal(actor)
+
+	environment = models.EnvironmentOrDefault(environment)
+
 	ctx, cancel := c.context()
 	defer cancel()
 
-	if err := c.storage.DeleteConfig(ctx, project, version); err != nil {
+	if err := c.checkChange(ctx, project, environment, version, AuditDelete, actor); err != nil {
+		c.logger.Error("failed delete config",
+			zap.String("project", project),
+			zap.String("environment", environment),
+			zap.Int("version", version),
+			zap.Error(err))
+
+		return err
+	}
+
+	if err := c.storage.DeleteConfig(ctx, project, environment, version); err != nil {
 		c.logger.Error("failed delete config", zap.Error(err))
 
 		return err
 	}
 
+	// The version is gone by now, so a stale cache only gets logged.
+	settings, err := c.project(ctx, project)
+	if err == nil {
+		err = c.invalidate(ctx, settings, environment)
+	}
+
+	if err != nil {
+		c.logger.Error("failed invalidate deleted config",
+			zap.String("project", project),
+			zap.String("environment", environment),
+			zap.Error(err))
+	}
+
+	c.audit(ctx, models.AuditEntry{
+		Actor:       actor.Name,
+		Action:      AuditDelete,
+		Project:     project,
+		Environment: environment,
+		Version:     version,
+	})
+
+	c.bus.Publish(events.Event{
+		Type:        events.Deleted,
+		Project:     project,
+		Environment: environment,
+		Version:     version,
+		Actor:       actor.Name,
+	})
+
 	return nil
 }
+
+func (c *Core) ListEnvironments(project string) ([]string, error) {
+	if project == "" {
+		return nil, ErrNilInput
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	return c.storage.ListEnvironments(ctx, project)
+}
@@ -0,0 +1,82 @@
+package core
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/osamikoyo/yoconf/diff"
+	"github.com/osamikoyo/yoconf/models"
+	"github.com/osamikoyo/yoconf/secrets"
+	"go.uber.org/zap"
+)
+
+const ActiveVersion = "active"
+
+func (c *Core) resolveChunk(project, environment, version string) (*models.Chunk, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	if version == "" || version == ActiveVersion {
+		return c.storage.GetChunk(ctx, project, environment)
+	}
+
+	number, err := strconv.Atoi(version)
+	if err != nil || number < 1 {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidVersion, version)
+	}
+
+	return c.storage.GetChunkByVersion(ctx, project, environment, number)
+}
+
+func (c *Core) Diff(project, environment, from, to string) (*models.Diff, error) {
+	if project == "" {
+		return nil, ErrNilInput
+	}
+
+	environment = models.EnvironmentOrDefault(environment)
+
+	fromChunk, err := c.resolveChunk(project, environment, from)
+	if err != nil {
+		c.logger.Error("failed resolve diff source",
+			zap.String("project", project),
+			zap.String("version", from),
+			zap.Error(err))
+
+		return nil, err
+	}
+
+	toChunk, err := c.resolveChunk(project, environment, to)
+	if err != nil {
+		c.logger.Error("failed resolve diff target",
+			zap.String("project", project),
+			zap.String("version", to),
+			zap.Error(err))
+
+		return nil, err
+	}
+
+	// Secrets never show up in diffs, whoever asks for them.
+	fromData, toData := secrets.Mask(fromChunk.Data), secrets.Mask(toChunk.Data)
+
+	result := &models.Diff{
+		Project:     project,
+		Environment: environment,
+		FromVersion: fromChunk.Version,
+		ToVersion:   toChunk.Version,
+		Unified: diff.Unified(
+			fmt.Sprintf("%s@%d", project, fromChunk.Version),
+			fmt.Sprintf("%s@%d", project, toChunk.Version),
+			fromData,
+			toData,
+		),
+	}
+
+	result.Changes, result.Structured = diff.Structural(fromData, toData)
+
+	c.logger.Info("successfully diffed versions",
+		zap.String("project", project),
+		zap.Int("from", fromChunk.Version),
+		zap.Int("to", toChunk.Version))
+
+	return result, nil
+}
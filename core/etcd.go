@@ -0,0 +1,470 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/osamikoyo/yoconf/auth"
+	"github.com/osamikoyo/yoconf/keypath"
+	"github.com/osamikoyo/yoconf/models"
+	"github.com/osamikoyo/yoconf/storage"
+)
+
+var (
+	ErrFutureRevision = errors.New("mvcc: required revision is a future revision")
+	ErrCompacted      = errors.New("mvcc: required revision has been compacted")
+	ErrCrossProject   = errors.New("ranges across projects are not supported")
+)
+
+// CompactedError is ErrCompacted along with Revision, the oldest revision the
+// request could have started from.
+type CompactedError struct {
+	Revision int64
+}
+
+func (e *CompactedError) Error() string {
+	return ErrCompacted.Error()
+}
+
+func (e *CompactedError) Unwrap() error {
+	return ErrCompacted
+}
+
+// WatchResync is how often a watch looks for changes on its own. The bus drops
+// events for slow subscribers, so a watch cannot rely on hearing of every one.
+const WatchResync = 5 * time.Second
+
+// RangeRequest is a read of the etcd compatible API. The first segment of
+// Key, after an optional leading slash, names the project as project or
+// project@environment, and the rest walks into its served config. RangeEnd
+// follows etcd: empty for Key alone and otherwise the end of the half-open
+// range [Key, RangeEnd). A range has to stay within the project of Key, so
+// "\x00" and any end past the project's keys fail with ErrCrossProject.
+type RangeRequest struct {
+	Key       string
+	RangeEnd  string
+	Revision  int64
+	Principal *auth.Principal
+}
+
+// WatchRequest watches the keys a RangeRequest would read, replaying changes
+// from StartRevision when it is set.
+type WatchRequest struct {
+	Key           string
+	RangeEnd      string
+	StartRevision int64
+	Principal     *auth.Principal
+}
+
+// keySpace is the project environment a key belongs to. Root is the key of
+// the whole config, as the client spelled it.
+type keySpace struct {
+	root        string
+	project     string
+	environment string
+}
+
+// parseKeySpace returns the environment of key, checking that the range up
+// to end stays within it. Every key of an environment sorts before its root
+// followed by "0", the byte after "/".
+func parseKeySpace(key, end string) (keySpace, error) {
+	lead, rest := "", key
+	if strings.HasPrefix(rest, "/") {
+		lead, rest = "/", rest[1:]
+	}
+
+	first, _, _ := strings.Cut(rest, "/")
+	project, environment, _ := strings.Cut(first, "@")
+
+	switch {
+	case project == "" && end == "":
+		return keySpace{}, ErrNilInput
+	case project == "", end == "\x00", end > lead+first+"0":
+		return keySpace{}, ErrCrossProject
+	}
+
+	return keySpace{
+		root:        lead + first,
+		project:     project,
+		environment: models.EnvironmentOrDefault(environment),
+	}, nil
+}
+
+// keyState is an environment as served at some revision. A nil chunk stands
+// for an environment without an active version.
+type keyState struct {
+	chunk  *models.Chunk
+	value  any
+	create int64
+	mod    int64
+}
+
+func (s *keyState) keyValue(key string, value []byte) models.KeyValue {
+	return models.KeyValue{
+		Key:            key,
+		Value:          value,
+		CreateRevision: s.create,
+		ModRevision:    s.mod,
+		Version:        s.chunk.Version,
+	}
+}
+
+// lookup returns a single key: the root holds the config as published and
+// any other key the value at its path.
+func (s *keyState) lookup(space keySpace, key string) []models.KeyValue {
+	if s.chunk == nil {
+		return nil
+	}
+
+	if key == space.root {
+		return []models.KeyValue{s.keyValue(key, []byte(s.chunk.Data))}
+	}
+
+	path, ok := strings.CutPrefix(key, space.root+"/")
+	if !ok {
+		return nil
+	}
+
+	value, err := keypath.GetSegments(s.value, strings.Split(path, "/"))
+	if err != nil {
+		return nil
+	}
+
+	data, err := kvValue(value)
+	if err != nil {
+		return nil
+	}
+
+	return []models.KeyValue{s.keyValue(key, data)}
+}
+
+// scan returns the root and every leaf that falls into [key, end), sorted by
+// key.
+func (s *keyState) scan(space keySpace, key, end string) []models.KeyValue {
+	if s.chunk == nil {
+		return nil
+	}
+
+	inRange := func(candidate string) bool {
+		return candidate >= key && candidate < end
+	}
+
+	result := []models.KeyValue{}
+	if inRange(space.root) {
+		result = append(result, s.keyValue(space.root, []byte(s.chunk.Data)))
+	}
+
+	_ = walkKV(space.root, s.value, func(leaf string, value any) error {
+		if !inRange(leaf) {
+			return nil
+		}
+
+		data, err := kvValue(value)
+		if err != nil {
+			return nil
+		}
+
+		result = append(result, s.keyValue(leaf, data))
+
+		return nil
+	})
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Key < result[j].Key
+	})
+
+	return result
+}
+
+func (s *keyState) read(space keySpace, key, end string) []models.KeyValue {
+	if end == "" {
+		return s.lookup(space, key)
+	}
+
+	return s.scan(space, key, end)
+}
+
+// compactRevision returns the last change to an environment or to anything
+// it is built from: its layers and its includes, all the way down.
+func (c *Core) compactRevision(ctx context.Context, space keySpace) (int64, error) {
+	compact := int64(0)
+	seen := map[string]bool{}
+	queue := []keySpace{space}
+
+	for len(queue) > 0 {
+		next := queue[0]
+		queue = queue[1:]
+
+		name := next.project + "/" + next.environment
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		latest, err := c.storage.RevisionAt(ctx, next.project, next.environment, math.MaxInt64)
+		if err == nil {
+			compact = max(compact, latest.ID)
+		} else if !errors.Is(err, storage.ErrNotFound) {
+			return 0, err
+		}
+
+		settings, err := c.project(ctx, next.project)
+		if err != nil {
+			return 0, err
+		}
+
+		for _, layer := range settings.Layers[next.environment] {
+			queue = append(queue, keySpace{project: next.project, environment: layer})
+		}
+
+		deps, err := c.storage.ListDependencies(ctx, next.project, next.environment)
+		if err != nil {
+			return 0, err
+		}
+
+		for _, dep := range deps {
+			queue = append(queue, keySpace{project: dep.TargetProject, environment: dep.TargetEnvironment})
+		}
+	}
+
+	return compact, nil
+}
+
+// stateAt rebuilds an environment as it was served at revision. Environments
+// that have not changed since revisions were first recorded are read from
+// their active version. Layers and includes are only known as they are now,
+// so a revision before the last change to any of them, or to the environment
+// itself, fails with a CompactedError rather than read differently than it
+// was served.
+func (c *Core) stateAt(ctx context.Context, space keySpace, revision int64, principal *auth.Principal) (*keyState, error) {
+	compact, err := c.compactRevision(ctx, space)
+	if err != nil {
+		return nil, err
+	}
+
+	if revision < compact {
+		return nil, &CompactedError{Revision: compact}
+	}
+
+	state := &keyState{}
+
+	first, err := c.storage.FirstRevision(ctx, space.project, space.environment)
+	if err == nil {
+		state.create = first.ID
+	} else if !errors.Is(err, storage.ErrNotFound) {
+		return nil, err
+	}
+
+	change, err := c.storage.RevisionAt(ctx, space.project, space.environment, revision)
+	switch {
+	case errors.Is(err, storage.ErrNotFound) && first != nil:
+		return state, nil
+	case errors.Is(err, storage.ErrNotFound):
+		state.chunk, err = c.storage.GetChunk(ctx, space.project, space.environment)
+	case err != nil:
+		return nil, err
+	case change.Version == 0:
+		return state, nil
+	default:
+		state.mod = change.ID
+		state.chunk, err = c.storage.GetChunkByVersion(ctx, space.project, space.environment, change.Version)
+	}
+	if errors.Is(err, storage.ErrNotFound) {
+		return &keyState{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if state.chunk, err = c.build(ctx, state.chunk); err != nil {
+		return nil, err
+	}
+
+	if state.chunk, err = c.present(state.chunk, principal); err != nil {
+		return nil, err
+	}
+
+	if state.value, err = parseData(state.chunk); err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+// latest reads an environment at the current revision. A change landing in
+// between compacts that revision, so the read is retried a few times.
+func (c *Core) latest(ctx context.Context, space keySpace, principal *auth.Principal) (*keyState, int64, error) {
+	for attempt := 1; ; attempt++ {
+		current, err := c.storage.CurrentRevision(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		state, err := c.stateAt(ctx, space, current, principal)
+		if !errors.Is(err, ErrCompacted) || attempt == RetrierCount {
+			return state, current, err
+		}
+	}
+}
+
+// CurrentRevision returns the latest revision of the global change sequence.
+func (c *Core) CurrentRevision() (int64, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	return c.storage.CurrentRevision(ctx)
+}
+
+// Range returns the keys of a request along with the current revision.
+func (c *Core) Range(req RangeRequest) ([]models.KeyValue, int64, error) {
+	space, err := parseKeySpace(req.Key, req.RangeEnd)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	current, err := c.storage.CurrentRevision(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if req.Revision > current {
+		return nil, current, ErrFutureRevision
+	}
+
+	var state *keyState
+	if req.Revision > 0 {
+		state, err = c.stateAt(ctx, space, req.Revision, req.Principal)
+	} else {
+		state, current, err = c.latest(ctx, space, req.Principal)
+	}
+	if err != nil {
+		return nil, current, err
+	}
+
+	return state.read(space, req.Key, req.RangeEnd), current, nil
+}
+
+// diffKeys turns two reads of the same keys into watch events stamped with
+// revision: puts for new and changed values, deletes for keys that are gone.
+func diffKeys(before, after []models.KeyValue, revision int64) []models.KeyEvent {
+	previous := make(map[string]models.KeyValue, len(before))
+	for _, kv := range before {
+		previous[kv.Key] = kv
+	}
+
+	result := []models.KeyEvent{}
+
+	for _, kv := range after {
+		old, existed := previous[kv.Key]
+		delete(previous, kv.Key)
+
+		if existed && bytes.Equal(old.Value, kv.Value) {
+			continue
+		}
+
+		event := models.KeyEvent{KV: kv}
+		if existed {
+			event.Prev = &old
+		}
+
+		result = append(result, event)
+	}
+
+	for _, kv := range before {
+		if old, gone := previous[kv.Key]; gone {
+			result = append(result, models.KeyEvent{
+				Deleted: true,
+				KV:      models.KeyValue{Key: old.Key, ModRevision: revision},
+				Prev:    &old,
+			})
+		}
+	}
+
+	return result
+}
+
+// Watch calls send with the events of every change to the watched keys until
+// ctx is done or send fails. A watch can only start from a revision the
+// environment can still be read at, and changes landing close together may
+// arrive as one.
+func (c *Core) Watch(ctx context.Context, req WatchRequest, send func(revision int64, events []models.KeyEvent) error) error {
+	space, err := parseKeySpace(req.Key, req.RangeEnd)
+	if err != nil {
+		return err
+	}
+
+	ch, unsubscribe := c.bus.Subscribe(64)
+	defer unsubscribe()
+
+	state, last, err := c.latest(ctx, space, req.Principal)
+	if err != nil {
+		return err
+	}
+
+	// Replaying from the start revision needs the keys as they were just
+	// before it, and those are the current ones only if nothing has changed
+	// since.
+	if req.StartRevision > 0 && req.StartRevision <= last {
+		compact, err := c.compactRevision(ctx, space)
+		if err != nil {
+			return err
+		}
+
+		if req.StartRevision <= compact {
+			return &CompactedError{Revision: compact + 1}
+		}
+	}
+
+	keys := state.read(space, req.Key, req.RangeEnd)
+
+	ticker := time.NewTicker(WatchResync)
+	defer ticker.Stop()
+
+	for {
+		for waiting := true; waiting; {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+				waiting = false
+			case event, ok := <-ch:
+				if !ok {
+					return nil
+				}
+
+				waiting = event.Project != space.project || event.Environment != space.environment
+			}
+		}
+
+		current, err := c.storage.CurrentRevision(ctx)
+		if err != nil {
+			return err
+		}
+
+		if current == last {
+			continue
+		}
+
+		if state, current, err = c.latest(ctx, space, req.Principal); err != nil {
+			return err
+		}
+
+		next := state.read(space, req.Key, req.RangeEnd)
+
+		if events := diffKeys(keys, next, current); len(events) > 0 {
+			if err = send(current, events); err != nil {
+				return err
+			}
+		}
+
+		keys, last = next, current
+	}
+}
@@ -0,0 +1,134 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/osamikoyo/yoconf/flags"
+	"github.com/osamikoyo/yoconf/models"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrInvalidProjectType = errors.New("invalid project type")
+	ErrNotFlagProject     = errors.New("not a flag project")
+)
+
+// FlagRequest asks for the flags of a project, or only Keys when given,
+// evaluated against Context.
+type FlagRequest struct {
+	Project     string
+	Environment string
+	Keys        []string
+	Context     flags.Context
+}
+
+// parsedFlags is a flag set together with the hash of the data it was parsed
+// from, so reads only parse again after the served config changed.
+type parsedFlags struct {
+	hash string
+	set  *flags.Set
+}
+
+func checkProjectType(project *models.Project) error {
+	switch project.Type {
+	case "", models.ProjectTypeConfig, models.ProjectTypeFlags:
+		return nil
+	default:
+		return fmt.Errorf("%w: %q", ErrInvalidProjectType, project.Type)
+	}
+}
+
+// checkFlags makes sure a version of a flag project defines valid flags.
+// Versions merged under overlays may be partial, so they are checked when
+// they are read instead.
+func checkFlags(chunk *models.Chunk, project *models.Project) error {
+	if project.Type != models.ProjectTypeFlags || len(project.Layers[chunk.Environment]) > 0 {
+		return nil
+	}
+
+	doc, err := parseData(chunk)
+	if err != nil {
+		return err
+	}
+
+	_, err = flags.Parse(doc)
+
+	return err
+}
+
+func (c *Core) flagSet(ctx context.Context, project, environment string) (*flags.Set, error) {
+	chunk, err := c.GetConfig(ReadRequest{
+		Project:     project,
+		Environment: environment,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	key := project + "/" + models.EnvironmentOrDefault(environment)
+	hash := models.ContentHash(chunk.Data)
+
+	if cached, ok := c.flagSets.Load(key); ok && cached.(parsedFlags).hash == hash {
+		return cached.(parsedFlags).set, nil
+	}
+
+	settings, err := c.project(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+
+	if settings.Type != models.ProjectTypeFlags {
+		return nil, fmt.Errorf("%w: %s", ErrNotFlagProject, project)
+	}
+
+	doc, err := parseData(chunk)
+	if err != nil {
+		return nil, err
+	}
+
+	set, err := flags.Parse(doc)
+	if err != nil {
+		c.logger.Error("failed parse flags",
+			zap.String("project", project),
+			zap.String("environment", environment),
+			zap.Error(err))
+
+		return nil, err
+	}
+
+	c.flagSets.Store(key, parsedFlags{hash: hash, set: set})
+
+	return set, nil
+}
+
+// forgetFlags drops the parsed flags of every environment of project.
+func (c *Core) forgetFlags(project string) {
+	c.flagSets.Range(func(key, _ any) bool {
+		if strings.HasPrefix(key.(string), project+"/") {
+			c.flagSets.Delete(key)
+		}
+
+		return true
+	})
+}
+
+// EvaluateFlags evaluates the flags of a flag project. Unknown keys come back
+// as results with an error code instead of failing the whole request.
+func (c *Core) EvaluateFlags(req FlagRequest) ([]flags.Result, error) {
+	if req.Project == "" {
+		return nil, ErrNilInput
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	set, err := c.flagSet(ctx, req.Project, req.Environment)
+	if err != nil {
+		return nil, err
+	}
+
+	return set.EvaluateAll(req.Keys, req.Context), nil
+}
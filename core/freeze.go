@@ -0,0 +1,317 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/osamikoyo/yoconf/auth"
+	"github.com/osamikoyo/yoconf/freeze"
+	"github.com/osamikoyo/yoconf/models"
+	"github.com/osamikoyo/yoconf/storage"
+	"go.uber.org/zap"
+)
+
+const (
+	AuditLock         = "lock"
+	AuditUnlock       = "unlock"
+	AuditSetFreeze    = "set_freeze"
+	AuditDeleteFreeze = "delete_freeze"
+	AuditBreakGlass   = "break_glass"
+
+	DefaultLockDuration = time.Hour
+)
+
+var (
+	ErrLocked = errors.New("project locked")
+	ErrFrozen = errors.New("change freeze")
+)
+
+// system is the principal background jobs act as. It holds no permissions,
+// so locks and freezes stop it like anyone else.
+func system(name string) *auth.Principal {
+	return &auth.Principal{Name: name}
+}
+
+// principal stands in the anonymous principal for a missing actor, so entry
+// points can name and check their caller without minding nil.
+func principal(actor *auth.Principal) *auth.Principal {
+	if actor == nil {
+		return auth.Anonymous
+	}
+
+	return actor
+}
+
+// restriction is a lock or freeze window that refuses a change.
+type restriction struct {
+	kind   error
+	what   string
+	reason string
+}
+
+// refusal returns the error a refused change fails with, wrapping ErrLocked
+// or ErrFrozen.
+func (r *restriction) refusal() error {
+	err := fmt.Errorf("%w: %s", r.kind, r.what)
+	if r.reason == "" {
+		return err
+	}
+
+	return fmt.Errorf("%w: %s", err, r.reason)
+}
+
+// blocked returns the restriction that keeps actor from changing project
+// right now, or nil. Only the authenticated owner of a lock is exempt from it.
+func (c *Core) blocked(ctx context.Context, project string, actor *auth.Principal, now time.Time) (*restriction, error) {
+	lock, err := c.storage.GetLock(ctx, project, now)
+	switch {
+	case err == nil && !actor.Is(lock.Owner):
+		return &restriction{
+			kind:   ErrLocked,
+			what:   fmt.Sprintf("%s is locked by %s until %s", project, lock.Owner, lock.ExpiresAt.Format(time.RFC3339)),
+			reason: lock.Reason,
+		}, nil
+	case err != nil && !errors.Is(err, storage.ErrNotFound):
+		return nil, err
+	}
+
+	windows, err := c.storage.ListFreezeWindows(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range windows {
+		if end, ok := freeze.Active(&windows[i], now); ok {
+			return &restriction{
+				kind:   ErrFrozen,
+				what:   fmt.Sprintf("%q is in effect until %s", windows[i].Name, end.UTC().Format(time.RFC3339)),
+				reason: windows[i].Reason,
+			}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// checkChange refuses changes to locked or frozen projects. Principals with
+// the break-glass permission pass anyway, and the bypass is audited.
+func (c *Core) checkChange(ctx context.Context, project, environment string, version int, action string, actor *auth.Principal) error {
+	actor = principal(actor)
+
+	restriction, err := c.blocked(ctx, project, actor, time.Now().UTC())
+	if err != nil || restriction == nil {
+		return err
+	}
+
+	reason := restriction.refusal()
+	if !actor.Can(auth.PermissionBreakGlass) {
+		return reason
+	}
+
+	c.logger.Warn("breaking glass",
+		zap.String("project", project),
+		zap.String("environment", environment),
+		zap.String("action", action),
+		zap.String("actor", actor.Name),
+		zap.NamedError("reason", reason))
+
+	c.audit(ctx, models.AuditEntry{
+		Actor:       actor.Name,
+		Action:      AuditBreakGlass,
+		Project:     project,
+		Environment: environment,
+		Version:     version,
+		Detail:      fmt.Sprintf("%s despite %v", action, reason),
+	})
+
+	return nil
+}
+
+// LockProject blocks changes to project by anyone but actor for duration.
+// The owner can lock again to renew it. Locks are owned by identity, so
+// anonymous callers cannot take them.
+func (c *Core) LockProject(project, reason string, duration time.Duration, actor *auth.Principal) (*models.Lock, error) {
+	if project == "" || actor == nil {
+		return nil, ErrNilInput
+	}
+
+	if !actor.Authenticated {
+		return nil, fmt.Errorf("%w: locking %s needs an authenticated caller", ErrNotAuthorized, project)
+	}
+
+	if duration <= 0 {
+		duration = DefaultLockDuration
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	now := time.Now().UTC()
+
+	lock := &models.Lock{
+		Project:   project,
+		Owner:     actor.Name,
+		Reason:    reason,
+		ExpiresAt: now.Add(duration),
+	}
+
+	acquired, err := c.storage.AcquireLock(ctx, lock, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if !acquired {
+		held, err := c.storage.GetLock(ctx, project, now)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("%w: %s is locked by %s until %s",
+			ErrLocked, project, held.Owner, held.ExpiresAt.Format(time.RFC3339))
+	}
+
+	detail := fmt.Sprintf("until %s", lock.ExpiresAt.Format(time.RFC3339))
+	if reason != "" {
+		detail += ": " + reason
+	}
+
+	c.audit(ctx, models.AuditEntry{
+		Actor:   actor.Name,
+		Action:  AuditLock,
+		Project: project,
+		Detail:  detail,
+	})
+
+	return lock, nil
+}
+
+func (c *Core) GetLock(project string) (*models.Lock, error) {
+	if project == "" {
+		return nil, ErrNilInput
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	return c.storage.GetLock(ctx, project, time.Now().UTC())
+}
+
+// UnlockProject releases a lock. Only its owner or a break-glass principal
+// may release it.
+func (c *Core) UnlockProject(project string, actor *auth.Principal) error {
+	if project == "" || actor == nil {
+		return ErrNilInput
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	lock, err := c.storage.GetLock(ctx, project, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+
+	detail := "released by owner"
+	if !actor.Is(lock.Owner) {
+		if !actor.Can(auth.PermissionBreakGlass) {
+			return fmt.Errorf("%w: %s is locked by %s", ErrNotAuthorized, project, lock.Owner)
+		}
+
+		detail = fmt.Sprintf("broke lock of %s", lock.Owner)
+	}
+
+	if err = c.storage.DeleteLock(ctx, project); err != nil {
+		return err
+	}
+
+	c.audit(ctx, models.AuditEntry{
+		Actor:   actor.Name,
+		Action:  AuditUnlock,
+		Project: project,
+		Detail:  detail,
+	})
+
+	return nil
+}
+
+// checkFreezeAccess refuses actors that may not change freeze windows. Lifting
+// a freeze is as strong as breaking through one, so it takes the same
+// permission, or an admin.
+func checkFreezeAccess(actor *auth.Principal) error {
+	if actor.Can(auth.PermissionBreakGlass) || actor.Can(auth.PermissionAdmin) {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %s may not manage freeze windows", ErrNotAuthorized, actor.Name)
+}
+
+// SetFreezeWindow creates a freeze window, or replaces the one with the same
+// ID. Windows without a project apply to every project.
+func (c *Core) SetFreezeWindow(window *models.FreezeWindow, actor *auth.Principal) (*models.FreezeWindow, error) {
+	if window == nil || actor == nil {
+		return nil, ErrNilInput
+	}
+
+	if err := checkFreezeAccess(actor); err != nil {
+		return nil, err
+	}
+
+	if err := freeze.Check(window); err != nil {
+		return nil, err
+	}
+
+	window.Author = actor.Name
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	if err := c.storage.SaveFreezeWindow(ctx, window); err != nil {
+		return nil, err
+	}
+
+	c.audit(ctx, models.AuditEntry{
+		Actor:   actor.Name,
+		Action:  AuditSetFreeze,
+		Project: window.Project,
+		Detail: fmt.Sprintf("window %d %q: %v from %s for %s %s",
+			window.ID, window.Name, window.Days, window.Start, window.Duration, window.Timezone),
+	})
+
+	return window, nil
+}
+
+func (c *Core) DeleteFreezeWindow(id uint, actor *auth.Principal) error {
+	if actor == nil {
+		return ErrNilInput
+	}
+
+	if err := checkFreezeAccess(actor); err != nil {
+		return err
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	if err := c.storage.DeleteFreezeWindow(ctx, id); err != nil {
+		return err
+	}
+
+	c.audit(ctx, models.AuditEntry{
+		Actor:  actor.Name,
+		Action: AuditDeleteFreeze,
+		Detail: fmt.Sprintf("window %d", id),
+	})
+
+	return nil
+}
+
+// ListFreezeWindows lists the windows that apply to project, including the
+// global ones. An empty project lists only the global windows.
+func (c *Core) ListFreezeWindows(project string) ([]models.FreezeWindow, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	return c.storage.ListFreezeWindows(ctx, project)
+}
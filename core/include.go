@@ -0,0 +1,511 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/osamikoyo/yoconf/events"
+	"github.com/osamikoyo/yoconf/format"
+	"github.com/osamikoyo/yoconf/keypath"
+	"github.com/osamikoyo/yoconf/merge"
+	"github.com/osamikoyo/yoconf/models"
+	"github.com/osamikoyo/yoconf/retrier"
+	"go.uber.org/zap"
+)
+
+// IncludeKey pulls other configs into a map, e.g. `$include: shared-db/prod`.
+// String values may embed references such as `${ref:shared-db/prod@3#database.host}`.
+// Both use the reference syntax project[/environment][@version][#key.path].
+const IncludeKey = "$include"
+
+var (
+	ErrInvalidReference = errors.New("invalid reference")
+	ErrIncludeCycle     = errors.New("include cycle")
+)
+
+var refPattern = regexp.MustCompile(`\$\{ref:([^}]+)\}`)
+
+type reference struct {
+	Project     string
+	Environment string
+	Version     int
+	Path        string
+}
+
+func parseReference(spec string) (reference, error) {
+	ref := reference{}
+
+	body, path, _ := strings.Cut(strings.TrimSpace(spec), "#")
+	body, version, pinned := strings.Cut(body, "@")
+	project, environment, _ := strings.Cut(body, "/")
+
+	if project == "" {
+		return ref, fmt.Errorf("%w: %q", ErrInvalidReference, spec)
+	}
+
+	ref.Project = project
+	ref.Environment = models.EnvironmentOrDefault(environment)
+	ref.Path = path
+
+	if pinned {
+		number, err := strconv.Atoi(version)
+		if err != nil || number < 1 {
+			return ref, fmt.Errorf("%w: %q", ErrInvalidReference, spec)
+		}
+
+		ref.Version = number
+	}
+
+	return ref, nil
+}
+
+func (r reference) key() string {
+	return r.Project + "/" + r.Environment
+}
+
+func includeSpecs(value any) ([]string, error) {
+	switch v := value.(type) {
+	case string:
+		return []string{v}, nil
+	case []any:
+		specs := make([]string, len(v))
+		for i, item := range v {
+			spec, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("%w: %v", ErrInvalidReference, item)
+			}
+
+			specs[i] = spec
+		}
+
+		return specs, nil
+	default:
+		return nil, fmt.Errorf("%w: %v", ErrInvalidReference, value)
+	}
+}
+
+// collectReferences walks parsed data and returns every include and reference in it.
+func collectReferences(value any, refs *[]reference) error {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, val := range v {
+			if key == IncludeKey {
+				specs, err := includeSpecs(val)
+				if err != nil {
+					return err
+				}
+
+				for _, spec := range specs {
+					ref, err := parseReference(spec)
+					if err != nil {
+						return err
+					}
+
+					*refs = append(*refs, ref)
+				}
+
+				continue
+			}
+
+			if err := collectReferences(val, refs); err != nil {
+				return err
+			}
+		}
+	case []any:
+		for _, val := range v {
+			if err := collectReferences(val, refs); err != nil {
+				return err
+			}
+		}
+	case string:
+		for _, match := range refPattern.FindAllStringSubmatch(v, -1) {
+			ref, err := parseReference(match[1])
+			if err != nil {
+				return err
+			}
+
+			*refs = append(*refs, ref)
+		}
+	}
+
+	return nil
+}
+
+func hasReferences(data string) bool {
+	return strings.Contains(data, IncludeKey) || strings.Contains(data, "${ref:")
+}
+
+// resolver resolves the references of one config. It remembers every config
+// it has resolved, so a diamond of includes reads each of them only once.
+type resolver struct {
+	core     *Core
+	ctx      context.Context
+	stack    []string
+	resolved map[reference]any
+}
+
+func (r *resolver) fetch(ref reference) (any, error) {
+	key := ref.key()
+	if slices.Contains(r.stack, key) {
+		return nil, fmt.Errorf("%w: %s", ErrIncludeCycle, strings.Join(append(r.stack, key), " -> "))
+	}
+
+	value, err := r.load(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err = keypath.Get(value, ref.Path)
+	if err != nil {
+		return nil, fmt.Errorf("%w: resolve %s: %v", ErrInvalidReference, key, err)
+	}
+
+	return value, nil
+}
+
+// load returns the resolved config a reference points to, ignoring its path.
+func (r *resolver) load(ref reference) (any, error) {
+	ref.Path = ""
+	if value, ok := r.resolved[ref]; ok {
+		return value, nil
+	}
+
+	key := ref.key()
+
+	var (
+		chunk *models.Chunk
+		err   error
+	)
+
+	if ref.Version > 0 {
+		chunk, err = r.core.storage.GetChunkByVersion(r.ctx, ref.Project, ref.Environment, ref.Version)
+	} else {
+		chunk, err = r.core.storage.GetChunk(r.ctx, ref.Project, ref.Environment)
+		if err == nil {
+			var project *models.Project
+			if project, err = r.core.project(r.ctx, ref.Project); err == nil {
+				chunk, err = r.core.mergeLayers(r.ctx, project, chunk)
+			}
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: resolve %s: %v", ErrInvalidReference, key, err)
+	}
+
+	value, err := parseData(chunk)
+	if err != nil {
+		return nil, fmt.Errorf("%w: resolve %s: %v", ErrInvalidReference, key, err)
+	}
+
+	r.stack = append(r.stack, key)
+	value, err = r.resolve(value)
+	r.stack = r.stack[:len(r.stack)-1]
+	if err != nil {
+		return nil, err
+	}
+
+	r.resolved[ref] = value
+
+	return value, nil
+}
+
+func stringify(value any) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case map[string]any, []any:
+		return "", fmt.Errorf("%w: cannot embed a map or list in a string", ErrInvalidReference)
+	default:
+		return fmt.Sprint(v), nil
+	}
+}
+
+func (r *resolver) resolveString(value string) (any, error) {
+	matches := refPattern.FindAllStringSubmatchIndex(value, -1)
+	if len(matches) == 0 {
+		return value, nil
+	}
+
+	if len(matches) == 1 && matches[0][0] == 0 && matches[0][1] == len(value) {
+		ref, err := parseReference(value[matches[0][2]:matches[0][3]])
+		if err != nil {
+			return nil, err
+		}
+
+		return r.fetch(ref)
+	}
+
+	sb := strings.Builder{}
+	last := 0
+
+	for _, match := range matches {
+		ref, err := parseReference(value[match[2]:match[3]])
+		if err != nil {
+			return nil, err
+		}
+
+		resolved, err := r.fetch(ref)
+		if err != nil {
+			return nil, err
+		}
+
+		str, err := stringify(resolved)
+		if err != nil {
+			return nil, err
+		}
+
+		sb.WriteString(value[last:match[0]])
+		sb.WriteString(str)
+		last = match[1]
+	}
+
+	sb.WriteString(value[last:])
+
+	return sb.String(), nil
+}
+
+func (r *resolver) resolve(value any) (any, error) {
+	switch v := value.(type) {
+	case map[string]any:
+		var base any
+
+		if include, ok := v[IncludeKey]; ok {
+			specs, err := includeSpecs(include)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, spec := range specs {
+				ref, err := parseReference(spec)
+				if err != nil {
+					return nil, err
+				}
+
+				included, err := r.fetch(ref)
+				if err != nil {
+					return nil, err
+				}
+
+				base = merge.Merge(base, included)
+			}
+		}
+
+		result := make(map[string]any, len(v))
+		for key, val := range v {
+			if key == IncludeKey {
+				continue
+			}
+
+			resolved, err := r.resolve(val)
+			if err != nil {
+				return nil, err
+			}
+
+			result[key] = resolved
+		}
+
+		if base == nil {
+			return result, nil
+		}
+
+		return merge.Merge(base, result), nil
+	case []any:
+		result := make([]any, len(v))
+		for i, val := range v {
+			resolved, err := r.resolve(val)
+			if err != nil {
+				return nil, err
+			}
+
+			result[i] = resolved
+		}
+
+		return result, nil
+	case string:
+		return r.resolveString(v)
+	default:
+		return v, nil
+	}
+}
+
+// resolveIncludes returns a copy of chunk with every include and reference
+// replaced by the data it points to.
+func (c *Core) resolveIncludes(ctx context.Context, chunk *models.Chunk) (*models.Chunk, error) {
+	if !hasReferences(chunk.Data) {
+		return chunk, nil
+	}
+
+	value, err := parseData(chunk)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &resolver{
+		core:     c,
+		ctx:      ctx,
+		stack:    []string{chunk.Project + "/" + chunk.Environment},
+		resolved: map[reference]any{},
+	}
+
+	resolved, err := r.resolve(value)
+	if err != nil {
+		return nil, err
+	}
+
+	target := chunk.Format
+	if target == "" {
+		target = format.YAML
+	}
+
+	data, err := format.Encode(target, resolved)
+	if err != nil {
+		return nil, err
+	}
+
+	result := *chunk
+	result.Data = data
+	result.Format = target
+	// The publish signature covers none of this; present signs it as served.
+	result.Signature = ""
+	result.SigningKey = ""
+
+	return &result, nil
+}
+
+// trackDependencies records which configs the active version of chunk reads from.
+func (c *Core) trackDependencies(ctx context.Context, chunk *models.Chunk) error {
+	refs := []reference{}
+
+	if hasReferences(chunk.Data) {
+		value, err := parseData(chunk)
+		if err != nil {
+			return err
+		}
+
+		if err = collectReferences(value, &refs); err != nil {
+			return err
+		}
+	}
+
+	seen := map[models.Dependency]bool{}
+	deps := []models.Dependency{}
+
+	for _, ref := range refs {
+		dep := models.Dependency{
+			Project:           chunk.Project,
+			Environment:       chunk.Environment,
+			TargetProject:     ref.Project,
+			TargetEnvironment: ref.Environment,
+			TargetVersion:     ref.Version,
+		}
+
+		if !seen[dep] {
+			seen[dep] = true
+			deps = append(deps, dep)
+		}
+	}
+
+	return c.storage.ReplaceDependencies(ctx, chunk.Project, chunk.Environment, deps)
+}
+
+type cacheTarget struct {
+	project     string
+	environment string
+}
+
+// invalidate drops the cached output of an environment together with every
+// environment built on top of it, either as a layer or through unpinned
+// includes, and notifies subscribers about the dependents that changed.
+func (c *Core) invalidate(ctx context.Context, project *models.Project, environment string) error {
+	origin := cacheTarget{project.Name, environment}
+	settings := map[string]*models.Project{project.Name: project}
+	seen := map[cacheTarget]bool{}
+	queue := []cacheTarget{origin}
+
+	for len(queue) > 0 {
+		target := queue[0]
+		queue = queue[1:]
+
+		if seen[target] {
+			continue
+		}
+		seen[target] = true
+
+		err := retrier.Try(RetrierCount, func() error {
+			return c.casher.DeleteChunk(ctx, target.project, target.environment)
+		})
+		if err != nil {
+			c.logger.Error("failed invalidate cache",
+				zap.String("project", target.project),
+				zap.String("environment", target.environment),
+				zap.Error(err))
+
+			return err
+		}
+
+		if target != origin {
+			c.bus.Publish(events.Event{
+				Type:        events.DependencyChanged,
+				Project:     target.project,
+				Environment: target.environment,
+				Source:      origin.project + "/" + origin.environment,
+			})
+		}
+
+		current, ok := settings[target.project]
+		if !ok {
+			if current, err = c.project(ctx, target.project); err != nil {
+				return err
+			}
+
+			settings[target.project] = current
+		}
+
+		for _, env := range dependents(current, target.environment) {
+			queue = append(queue, cacheTarget{target.project, env})
+		}
+
+		deps, err := c.storage.ListDependents(ctx, target.project, target.environment)
+		if err != nil {
+			return err
+		}
+
+		for _, dep := range deps {
+			if dep.TargetVersion == 0 {
+				queue = append(queue, cacheTarget{dep.Project, dep.Environment})
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *Core) ListDependencies(project, environment string) ([]models.Dependency, []models.Dependency, error) {
+	if project == "" {
+		return nil, nil, ErrNilInput
+	}
+
+	environment = models.EnvironmentOrDefault(environment)
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	uses, err := c.storage.ListDependencies(ctx, project, environment)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	usedBy, err := c.storage.ListDependents(ctx, project, environment)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return uses, usedBy, nil
+}
@@ -0,0 +1,55 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const DefaultRewrapInterval = time.Hour
+
+// RewrapKeys moves stored and cached chunks under the current master key and
+// returns how many records were rewritten.
+func (c *Core) RewrapKeys(ctx context.Context) (int, error) {
+	stored, err := c.storage.RewrapChunks(ctx)
+	if err != nil {
+		c.logger.Error("failed rewrap stored chunks", zap.Error(err))
+
+		return stored, err
+	}
+
+	cached, err := c.casher.Rewrap(ctx)
+	if err != nil {
+		c.logger.Error("failed rewrap cached chunks", zap.Error(err))
+
+		return stored + cached, err
+	}
+
+	return stored + cached, nil
+}
+
+// RunRewrap rewraps records on every tick until ctx is done, so that rotating
+// the master key in the keyfile eventually reaches every record.
+func (c *Core) RunRewrap(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultRewrapInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		rewritten, err := c.RewrapKeys(ctx)
+		if err == nil && rewritten > 0 {
+			c.logger.Info("rewrapped records under current master key",
+				zap.Int("records", rewritten))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/osamikoyo/yoconf/format"
+	"github.com/osamikoyo/yoconf/merge"
+	"github.com/osamikoyo/yoconf/models"
+	"github.com/osamikoyo/yoconf/storage"
+	"go.uber.org/zap"
+)
+
+var ErrInvalidLayers = errors.New("invalid layers")
+
+func checkLayers(project *models.Project) error {
+	for environment, layers := range project.Layers {
+		if slices.Contains(layers, environment) {
+			return fmt.Errorf("%w: %q lists itself as a layer", ErrInvalidLayers, environment)
+		}
+	}
+
+	return nil
+}
+
+// dependents returns the environments that merge environment as one of their layers.
+func dependents(project *models.Project, environment string) []string {
+	result := []string{}
+	for name, layers := range project.Layers {
+		if slices.Contains(layers, environment) {
+			result = append(result, name)
+		}
+	}
+
+	return result
+}
+
+// mergeLayers returns a copy of chunk whose data is the deep merge of the
+// active versions of its layers followed by the chunk itself.
+func (c *Core) mergeLayers(ctx context.Context, project *models.Project, chunk *models.Chunk) (*models.Chunk, error) {
+	layers := project.Layers[chunk.Environment]
+	if len(layers) == 0 {
+		return chunk, nil
+	}
+
+	values := make([]any, 0, len(layers)+1)
+	versions := make([]models.LayerVersion, 0, len(layers)+1)
+
+	for _, environment := range layers {
+		layer, err := c.storage.GetChunk(ctx, chunk.Project, environment)
+		if errors.Is(err, storage.ErrNotFound) {
+			c.logger.Warn("skipping layer without active version",
+				zap.String("project", chunk.Project),
+				zap.String("environment", chunk.Environment),
+				zap.String("layer", environment))
+
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := parseData(layer)
+		if err != nil {
+			return nil, fmt.Errorf("layer %q: %w", environment, err)
+		}
+
+		values = append(values, value)
+		versions = append(versions, models.LayerVersion{
+			Environment: environment,
+			Version:     layer.Version,
+		})
+	}
+
+	value, err := parseData(chunk)
+	if err != nil {
+		return nil, err
+	}
+
+	values = append(values, value)
+	versions = append(versions, models.LayerVersion{
+		Environment: chunk.Environment,
+		Version:     chunk.Version,
+	})
+
+	target := chunk.Format
+	if target == "" {
+		target = format.YAML
+	}
+
+	data, err := format.Encode(target, merge.All(values...))
+	if err != nil {
+		return nil, err
+	}
+
+	merged := *chunk
+	merged.Data = data
+	merged.Format = target
+	merged.Layers = versions
+	// The publish signature covers none of this; present signs it as served.
+	merged.Signature = ""
+	merged.SigningKey = ""
+
+	return &merged, nil
+}
@@ -0,0 +1,244 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/osamikoyo/yoconf/auth"
+	"github.com/osamikoyo/yoconf/events"
+	"github.com/osamikoyo/yoconf/models"
+	"github.com/osamikoyo/yoconf/storage"
+	"go.uber.org/zap"
+)
+
+const (
+	ActorOverride = "override"
+
+	AuditStartOverride  = "start_override"
+	AuditExtendOverride = "extend_override"
+	AuditRevertOverride = "revert_override"
+
+	OverrideLease           = time.Minute
+	DefaultOverrideInterval = time.Second
+)
+
+var ErrInvalidOverride = errors.New("invalid override")
+
+// StartOverride activates version for duration and then restores the version
+// that is active now.
+func (c *Core) StartOverride(project, environment string, version int, duration time.Duration, actor *auth.Principal) (*models.Override, error) {
+	if project == "" || version < 1 {
+		return nil, ErrNilInput
+	}
+
+	actor = principal(actor)
+
+	if duration <= 0 {
+		return nil, fmt.Errorf("%w: duration must be positive", ErrInvalidOverride)
+	}
+
+	environment = models.EnvironmentOrDefault(environment)
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	if _, err := c.storage.GetOverride(ctx, project, environment); err == nil {
+		return nil, fmt.Errorf("%w: %s/%s already has an override, extend or end it", ErrInvalidOverride, project, environment)
+	} else if !errors.Is(err, storage.ErrNotFound) {
+		return nil, err
+	}
+
+	active, err := c.storage.GetChunk(ctx, project, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	if active.Version == version {
+		return nil, fmt.Errorf("%w: version %d is already active", ErrInvalidOverride, version)
+	}
+
+	override := &models.Override{
+		Project:         project,
+		Environment:     environment,
+		Version:         version,
+		PreviousVersion: active.Version,
+		ExpiresAt:       time.Now().UTC().Add(duration),
+		Author:          actor.Name,
+	}
+
+	// The override is stored with the activation, so the version does not
+	// stay live when storing it fails.
+	err = c.activate(project, environment, version, actor, models.Precondition{Version: active.Version}, func(ctx context.Context) error {
+		return c.storage.StartOverride(ctx, override, models.Precondition{Version: active.Version})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.audit(ctx, models.AuditEntry{
+		Actor:       actor.Name,
+		Action:      AuditStartOverride,
+		Project:     project,
+		Environment: environment,
+		Version:     version,
+		Detail:      fmt.Sprintf("reverts to version %d at %s", active.Version, override.ExpiresAt.Format(time.RFC3339)),
+	})
+
+	return override, nil
+}
+
+func (c *Core) GetOverride(project, environment string) (*models.Override, error) {
+	if project == "" {
+		return nil, ErrNilInput
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	return c.storage.GetOverride(ctx, project, models.EnvironmentOrDefault(environment))
+}
+
+// ExtendOverride pushes the expiry of a running override back by duration.
+func (c *Core) ExtendOverride(project, environment string, duration time.Duration, actor *auth.Principal) (*models.Override, error) {
+	if duration <= 0 {
+		return nil, fmt.Errorf("%w: duration must be positive", ErrInvalidOverride)
+	}
+
+	actor = principal(actor)
+
+	override, err := c.GetOverride(project, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	if err = c.checkChange(ctx, override.Project, override.Environment, override.Version, AuditExtendOverride, actor); err != nil {
+		return nil, err
+	}
+
+	override.ExpiresAt = override.ExpiresAt.Add(duration)
+
+	if err = c.storage.SaveOverride(ctx, override); err != nil {
+		return nil, err
+	}
+
+	c.audit(ctx, models.AuditEntry{
+		Actor:       actor.Name,
+		Action:      AuditExtendOverride,
+		Project:     override.Project,
+		Environment: override.Environment,
+		Version:     override.Version,
+		Detail:      fmt.Sprintf("reverts at %s", override.ExpiresAt.Format(time.RFC3339)),
+	})
+
+	return override, nil
+}
+
+// EndOverride reverts an override right away.
+func (c *Core) EndOverride(project, environment string, actor *auth.Principal) error {
+	actor = principal(actor)
+
+	override, err := c.GetOverride(project, environment)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	return c.revertOverride(ctx, override, actor)
+}
+
+// revertOverride restores the previous version, unless someone activated a
+// different version in the meantime, and drops the override.
+func (c *Core) revertOverride(ctx context.Context, override *models.Override, actor *auth.Principal) error {
+	detail := fmt.Sprintf("restored version %d", override.PreviousVersion)
+
+	err := c.RollOn(override.Project, override.Environment, override.PreviousVersion, actor,
+		models.Precondition{Version: override.Version})
+	switch {
+	case errors.Is(err, ErrConflict):
+		detail = fmt.Sprintf("version %d is no longer active, nothing restored", override.Version)
+	case err != nil:
+		return err
+	}
+
+	if err = c.storage.DeleteOverride(ctx, override.Project, override.Environment); err != nil {
+		return err
+	}
+
+	c.audit(ctx, models.AuditEntry{
+		Actor:       actor.Name,
+		Action:      AuditRevertOverride,
+		Project:     override.Project,
+		Environment: override.Environment,
+		Version:     override.PreviousVersion,
+		Detail:      detail,
+	})
+
+	c.bus.Publish(events.Event{
+		Type:        events.OverrideReverted,
+		Project:     override.Project,
+		Environment: override.Environment,
+		Version:     override.PreviousVersion,
+		Actor:       actor.Name,
+	})
+
+	return nil
+}
+
+func (c *Core) revertExpiredOverrides(ctx context.Context, owner string) error {
+	now := time.Now().UTC()
+
+	expired, err := c.storage.ExpiredOverrides(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	for _, override := range expired {
+		claimed, err := c.storage.ClaimOverride(ctx, override.Project, override.Environment, owner, now, OverrideLease)
+		if err != nil {
+			return err
+		}
+
+		if !claimed {
+			continue
+		}
+
+		if err = c.revertOverride(ctx, &override, system(ActorOverride)); err != nil {
+			c.logger.Error("failed revert override",
+				zap.String("project", override.Project),
+				zap.String("environment", override.Environment),
+				zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+// RunOverrides reverts expired overrides on every tick until ctx is done.
+func (c *Core) RunOverrides(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultOverrideInterval
+	}
+
+	owner := leaseOwner()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := c.revertExpiredOverrides(ctx, owner); err != nil {
+				c.logger.Error("failed revert overrides", zap.Error(err))
+			}
+		}
+	}
+}
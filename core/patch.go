@@ -0,0 +1,90 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/osamikoyo/yoconf/auth"
+	"github.com/osamikoyo/yoconf/models"
+	"github.com/osamikoyo/yoconf/patch"
+	"github.com/osamikoyo/yoconf/secrets"
+	"go.uber.org/zap"
+)
+
+// PatchRequest changes the data of Base, which must still be the active
+// version, with a JSON patch or merge patch.
+type PatchRequest struct {
+	Project     string
+	Environment string
+	Base        int
+	Kind        string
+	Patch       []byte
+}
+
+// Patch publishes the patched data of the base version as a new active
+// version. It fails with ErrConflict once another version has taken over.
+func (c *Core) Patch(req PatchRequest, actor *auth.Principal) (*models.Chunk, error) {
+	if req.Project == "" || req.Base < 1 {
+		return nil, ErrNilInput
+	}
+
+	environment := models.EnvironmentOrDefault(req.Environment)
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	base, err := c.storage.GetChunkByVersion(ctx, req.Project, environment, req.Base)
+	if err != nil {
+		return nil, err
+	}
+
+	if !base.InUse {
+		return nil, fmt.Errorf("%w: version %d is no longer active", ErrConflict, req.Base)
+	}
+
+	// A patch works on plaintext secrets, and test or copy operations would
+	// hand them to the caller, so only secret readers may patch them.
+	if secrets.HasSecrets(base.Data) && !actor.Can(auth.PermissionSecretRead) {
+		return nil, fmt.Errorf("%w: version %d holds secrets", ErrNotAuthorized, req.Base)
+	}
+
+	data, err := c.sealer.Unseal(base.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	if data, err = patch.Apply(req.Kind, base.Format, data, req.Patch); err != nil {
+		c.logger.Error("failed apply patch",
+			zap.String("project", req.Project),
+			zap.String("environment", environment),
+			zap.Int("base", req.Base),
+			zap.Error(err))
+
+		return nil, err
+	}
+
+	latest, err := c.storage.LatestVersion(ctx, req.Project, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	patched := &models.Chunk{
+		Project:     req.Project,
+		Environment: environment,
+		InUse:       true,
+		Data:        data,
+		Version:     latest + 1,
+		Format:      base.Format,
+	}
+
+	if err = c.NewConfig(patched, actor, models.Precondition{Version: req.Base}); err != nil {
+		return nil, err
+	}
+
+	c.logger.Info("successfully patched chunk",
+		zap.String("project", req.Project),
+		zap.String("environment", environment),
+		zap.Int("base", req.Base),
+		zap.Int("version", patched.Version))
+
+	return c.present(patched, auth.Anonymous)
+}
@@ -0,0 +1,42 @@
+package core
+
+import (
+	"github.com/osamikoyo/yoconf/format"
+	"github.com/osamikoyo/yoconf/keypath"
+	"github.com/osamikoyo/yoconf/models"
+)
+
+// SelectPath returns a copy of chunk holding only the value at path, a dotted
+// path or JSONPath, encoded in target or else in the format of the chunk. The
+// selection no longer matches the signature, so it is dropped.
+func SelectPath(chunk *models.Chunk, path, target string) (*models.Chunk, error) {
+	value, err := parseData(chunk)
+	if err != nil {
+		return nil, err
+	}
+
+	if value, err = keypath.Get(value, path); err != nil {
+		return nil, err
+	}
+
+	if target == "" {
+		target = chunk.Format
+	}
+
+	if target == "" {
+		target = format.YAML
+	}
+
+	data, err := format.Encode(target, value)
+	if err != nil {
+		return nil, err
+	}
+
+	result := *chunk
+	result.Data = data
+	result.Format = target
+	result.Signature = ""
+	result.SigningKey = ""
+
+	return &result, nil
+}
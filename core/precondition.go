@@ -0,0 +1,62 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/osamikoyo/yoconf/models"
+	"github.com/osamikoyo/yoconf/retrier"
+	"github.com/osamikoyo/yoconf/storage"
+)
+
+// ErrConflict is returned when a write's precondition no longer holds.
+var ErrConflict = storage.ErrConflict
+
+// checkPrecondition fails early, naming the current active version, when pre
+// no longer holds. Storage checks it again atomically with the write.
+func (c *Core) checkPrecondition(ctx context.Context, project, environment string, pre models.Precondition) error {
+	if pre.IsZero() {
+		return nil
+	}
+
+	active, err := c.storage.GetChunk(ctx, project, environment)
+	if errors.Is(err, storage.ErrNotFound) {
+		active = nil
+	} else if err != nil {
+		return err
+	}
+
+	if pre.Holds(active) {
+		return nil
+	}
+
+	if active == nil {
+		return fmt.Errorf("%w: %s/%s has no active version", ErrConflict, project, environment)
+	}
+
+	return fmt.Errorf("%w: active version of %s/%s is %d (hash %s)",
+		ErrConflict, project, environment, active.Version, active.Hash)
+}
+
+// write retries transient storage failures, but not conflicts, which would
+// fail the same way again.
+func write(opr retrier.Operation) error {
+	var conflict error
+
+	err := retrier.Try(RetrierCount, func() error {
+		err := opr()
+		if errors.Is(err, ErrConflict) {
+			conflict = err
+
+			return nil
+		}
+
+		return err
+	})
+	if conflict != nil {
+		return conflict
+	}
+
+	return err
+}
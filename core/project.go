@@ -0,0 +1,103 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/osamikoyo/yoconf/auth"
+	"github.com/osamikoyo/yoconf/format"
+	"github.com/osamikoyo/yoconf/models"
+	"github.com/osamikoyo/yoconf/storage"
+	"go.uber.org/zap"
+)
+
+func (c *Core) project(ctx context.Context, name string) (*models.Project, error) {
+	project, err := c.storage.GetProject(ctx, name)
+	if errors.Is(err, storage.ErrNotFound) {
+		return &models.Project{Name: name}, nil
+	}
+
+	return project, err
+}
+
+func (c *Core) GetProject(name string) (*models.Project, error) {
+	if name == "" {
+		return nil, ErrNilInput
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	return c.project(ctx, name)
+}
+
+const AuditSetProject = "set_project"
+
+// SetProject replaces the settings of a project. Settings decide who may
+// change it and how, so they take the admin permission and respect locks
+// and freezes like any other change.
+func (c *Core) SetProject(project *models.Project, actor *auth.Principal) error {
+	if project == nil || project.Name == "" || actor == nil {
+		return ErrNilInput
+	}
+
+	if !actor.Can(auth.PermissionAdmin) {
+		return fmt.Errorf("%w: %s may not change project settings", ErrNotAuthorized, actor.Name)
+	}
+
+	var err error
+	if project.Format, err = format.Normalize(project.Format); err != nil {
+		return err
+	}
+
+	if err = checkLayers(project); err != nil {
+		return err
+	}
+
+	if err = checkProjectType(project); err != nil {
+		return err
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	if err = c.checkChange(ctx, project.Name, "", 0, AuditSetProject, actor); err != nil {
+		return err
+	}
+
+	current, err := c.project(ctx, project.Name)
+	if err != nil {
+		return err
+	}
+
+	project.SchemaVersion = current.SchemaVersion
+
+	if err = c.storage.SaveProject(ctx, project); err != nil {
+		c.logger.Error("failed set project",
+			zap.String("project", project.Name),
+			zap.Error(err))
+
+		return err
+	}
+
+	c.audit(ctx, models.AuditEntry{
+		Actor:   actor.Name,
+		Action:  AuditSetProject,
+		Project: project.Name,
+		Detail: fmt.Sprintf("protected %t, %d approvals from %v",
+			project.Protected, requiredApprovals(project), project.Approvers),
+	})
+
+	c.forgetFlags(project.Name)
+
+	for _, settings := range []*models.Project{current, project} {
+		for environment := range settings.Layers {
+			if err = c.casher.DeleteChunk(ctx, project.Name, environment); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
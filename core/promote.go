@@ -0,0 +1,105 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/osamikoyo/yoconf/auth"
+	"github.com/osamikoyo/yoconf/models"
+	"go.uber.org/zap"
+)
+
+var ErrPromotionNotAllowed = errors.New("promotion not allowed")
+
+// checkPromotionPath allows only single forward steps along the project
+// promotion path. Projects without a path can promote between any environments.
+func checkPromotionPath(project *models.Project, source, target string) error {
+	if source == target {
+		return fmt.Errorf("%w: source and target are both %q", ErrPromotionNotAllowed, source)
+	}
+
+	if len(project.PromotionPath) == 0 {
+		return nil
+	}
+
+	i := slices.Index(project.PromotionPath, source)
+	if i == -1 || i+1 >= len(project.PromotionPath) || project.PromotionPath[i+1] != target {
+		return fmt.Errorf("%w: %s -> %s is not on path %v",
+			ErrPromotionNotAllowed, source, target, project.PromotionPath)
+	}
+
+	return nil
+}
+
+// Promote copies a version of one environment into a new active version of
+// another, keeping a reference to where it came from.
+func (c *Core) Promote(project, source string, version int, target string, actor *auth.Principal) (*models.Chunk, error) {
+	if project == "" || target == "" || version < 1 {
+		return nil, ErrNilInput
+	}
+
+	actor = principal(actor)
+
+	source = models.EnvironmentOrDefault(source)
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	settings, err := c.project(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = checkPromotionPath(settings, source, target); err != nil {
+		c.logger.Error("failed promote chunk",
+			zap.String("project", project),
+			zap.String("source", source),
+			zap.String("target", target),
+			zap.Error(err))
+
+		return nil, err
+	}
+
+	origin, err := c.storage.GetChunkByVersion(ctx, project, source, version)
+	if err != nil {
+		return nil, err
+	}
+
+	latest, err := c.storage.LatestVersion(ctx, project, target)
+	if err != nil {
+		return nil, err
+	}
+
+	// Publishing seals and signs the data again, so it starts from the
+	// plaintext secrets, just like a fresh publish.
+	data, err := c.sealer.Unseal(origin.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	promoted := &models.Chunk{
+		Project:           project,
+		Environment:       target,
+		InUse:             true,
+		Data:              data,
+		Version:           latest + 1,
+		Format:            origin.Format,
+		Author:            actor.Name,
+		SourceEnvironment: source,
+		SourceVersion:     origin.Version,
+	}
+
+	if err = c.NewConfig(promoted, actor, models.Precondition{}); err != nil {
+		return nil, err
+	}
+
+	c.logger.Info("successfully promoted chunk",
+		zap.String("project", project),
+		zap.String("source", source),
+		zap.Int("source_version", origin.Version),
+		zap.String("target", target),
+		zap.Int("version", promoted.Version))
+
+	return c.present(promoted, auth.Anonymous)
+}
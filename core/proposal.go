@@ -0,0 +1,246 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/osamikoyo/yoconf/auth"
+	"github.com/osamikoyo/yoconf/events"
+	"github.com/osamikoyo/yoconf/models"
+	"go.uber.org/zap"
+)
+
+const (
+	AuditPropose = "propose"
+	AuditApprove = "approve"
+	AuditReject  = "reject"
+)
+
+var (
+	ErrInvalidProposal  = errors.New("invalid proposal")
+	ErrApprovalRequired = errors.New("approval required")
+	ErrNotAuthorized    = errors.New("not authorized")
+)
+
+func requiredApprovals(project *models.Project) int {
+	return max(project.RequiredApprovals, 1)
+}
+
+// propose stores a sealed and signed chunk of a protected project as an
+// inactive version waiting for review. The two-person rule compares
+// identities, so only authenticated principals can propose.
+func (c *Core) propose(ctx context.Context, project *models.Project, chunk *models.Chunk, actor *auth.Principal) error {
+	if actor == nil || !actor.Authenticated {
+		return fmt.Errorf("%w: changes to protected project %s need an authenticated author", ErrNotAuthorized, project.Name)
+	}
+
+	chunk.InUse = false
+
+	proposal := &models.Proposal{
+		Project:     chunk.Project,
+		Environment: chunk.Environment,
+		Version:     chunk.Version,
+		Author:      chunk.Author,
+		Proposer:    actor.Name,
+		Status:      models.ProposalPending,
+		Required:    requiredApprovals(project),
+	}
+
+	if err := c.storage.CreateProposal(ctx, chunk, proposal); err != nil {
+		c.logger.Error("failed create proposal", zap.Error(err))
+
+		return err
+	}
+
+	c.audit(ctx, models.AuditEntry{
+		Actor:       chunk.Author,
+		Action:      AuditPropose,
+		Project:     chunk.Project,
+		Environment: chunk.Environment,
+		Version:     chunk.Version,
+		Detail:      fmt.Sprintf("proposal %d", proposal.ID),
+	})
+
+	c.bus.Publish(events.Event{
+		Type:        events.ProposalCreated,
+		Project:     chunk.Project,
+		Environment: chunk.Environment,
+		Version:     chunk.Version,
+		Actor:       chunk.Author,
+	})
+
+	return nil
+}
+
+// checkApproval refuses to serve versions that were proposed but not approved.
+func (c *Core) checkApproval(ctx context.Context, chunk *models.Chunk) error {
+	if chunk.ProposalID == 0 {
+		return nil
+	}
+
+	proposal, err := c.storage.GetProposal(ctx, chunk.ProposalID)
+	if err != nil {
+		return err
+	}
+
+	if proposal.Status != models.ProposalApproved {
+		return fmt.Errorf("%w: version %d is proposal %d which is %s",
+			ErrApprovalRequired, chunk.Version, proposal.ID, proposal.Status)
+	}
+
+	return nil
+}
+
+func (c *Core) GetProposal(id uint) (*models.Proposal, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	return c.storage.GetProposal(ctx, id)
+}
+
+// ListProposals lists the proposals of a project, optionally only those
+// with the given status.
+func (c *Core) ListProposals(project, status string) ([]models.Proposal, error) {
+	if project == "" {
+		return nil, ErrNilInput
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	return c.storage.ListProposals(ctx, project, status)
+}
+
+// review records a decision of principal on a pending proposal.
+func (c *Core) review(ctx context.Context, id uint, principal *auth.Principal, decision, comment string) (*models.Proposal, error) {
+	proposal, err := c.storage.GetProposal(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if proposal.Status != models.ProposalPending {
+		return nil, fmt.Errorf("%w: proposal %d is already %s", ErrInvalidProposal, id, proposal.Status)
+	}
+
+	settings, err := c.project(ctx, proposal.Project)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case !principal.Can(auth.PermissionApprove):
+		return nil, fmt.Errorf("%w: %s may not review changes", ErrNotAuthorized, principal.Name)
+	case len(settings.Approvers) > 0 && !slices.Contains(settings.Approvers, principal.Name):
+		return nil, fmt.Errorf("%w: %s is not an approver of %s", ErrNotAuthorized, principal.Name, proposal.Project)
+	case principal.Is(proposal.Proposer), principal.Name == proposal.Author:
+		return nil, fmt.Errorf("%w: %s cannot review their own change", ErrNotAuthorized, principal.Name)
+	}
+
+	for _, review := range proposal.Reviews {
+		if review.Reviewer == principal.Name {
+			return nil, fmt.Errorf("%w: %s already reviewed proposal %d", ErrInvalidProposal, principal.Name, id)
+		}
+	}
+
+	proposal.Reviews = append(proposal.Reviews, models.Review{
+		Reviewer: principal.Name,
+		Decision: decision,
+		Comment:  comment,
+		Time:     time.Now().UTC(),
+	})
+
+	switch {
+	case decision == models.ReviewReject:
+		proposal.Status = models.ProposalRejected
+	case proposal.Approvals() >= proposal.Required:
+		proposal.Status = models.ProposalApproved
+	}
+
+	ok, err := c.storage.UpdateProposal(ctx, proposal)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return nil, fmt.Errorf("%w: proposal %d was reviewed concurrently, retry", ErrConflict, id)
+	}
+
+	action := AuditApprove
+	if decision == models.ReviewReject {
+		action = AuditReject
+	}
+
+	c.audit(ctx, models.AuditEntry{
+		Actor:       principal.Name,
+		Action:      action,
+		Project:     proposal.Project,
+		Environment: proposal.Environment,
+		Version:     proposal.Version,
+		Detail:      fmt.Sprintf("proposal %d: %s", id, comment),
+	})
+
+	return proposal, nil
+}
+
+// ApproveProposal records an approval and activates the proposed version
+// once the project's required number of approvals is reached.
+func (c *Core) ApproveProposal(id uint, principal *auth.Principal, comment string) (*models.Proposal, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	proposal, err := c.review(ctx, id, principal, models.ReviewApprove, comment)
+	if err != nil {
+		c.logger.Error("failed approve proposal",
+			zap.Uint("id", id),
+			zap.String("reviewer", principal.Name),
+			zap.Error(err))
+
+		return nil, err
+	}
+
+	c.bus.Publish(events.Event{
+		Type:        events.ProposalApproved,
+		Project:     proposal.Project,
+		Environment: proposal.Environment,
+		Version:     proposal.Version,
+		Actor:       principal.Name,
+	})
+
+	if proposal.Status != models.ProposalApproved {
+		return proposal, nil
+	}
+
+	if err = c.RollOn(proposal.Project, proposal.Environment, proposal.Version, principal, models.Precondition{}); err != nil {
+		return nil, err
+	}
+
+	return proposal, nil
+}
+
+func (c *Core) RejectProposal(id uint, principal *auth.Principal, comment string) (*models.Proposal, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	proposal, err := c.review(ctx, id, principal, models.ReviewReject, comment)
+	if err != nil {
+		c.logger.Error("failed reject proposal",
+			zap.Uint("id", id),
+			zap.String("reviewer", principal.Name),
+			zap.Error(err))
+
+		return nil, err
+	}
+
+	c.bus.Publish(events.Event{
+		Type:        events.ProposalRejected,
+		Project:     proposal.Project,
+		Environment: proposal.Environment,
+		Version:     proposal.Version,
+		Actor:       principal.Name,
+	})
+
+	return proposal, nil
+}
@@ -0,0 +1,364 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"time"
+
+	"github.com/osamikoyo/yoconf/auth"
+	"github.com/osamikoyo/yoconf/events"
+	"github.com/osamikoyo/yoconf/models"
+	"github.com/osamikoyo/yoconf/storage"
+	"go.uber.org/zap"
+)
+
+const (
+	ActorRollout = "rollout"
+
+	AuditStartRollout = "start_rollout"
+	AuditSetRollout   = "set_rollout"
+	AuditAbortRollout = "abort_rollout"
+
+	DefaultRolloutInterval = time.Minute
+)
+
+var ErrInvalidRollout = errors.New("invalid rollout")
+
+// bucket places a client in one of 100 buckets. The hash ignores the version
+// being rolled out, so the same clients go first in every rollout of an
+// environment and a client never flips back while the percentage grows.
+func bucket(project, environment, clientID string) int {
+	h := fnv.New32a()
+	h.Write([]byte(project + "/" + environment + "/" + clientID))
+
+	return int(h.Sum32() % 100)
+}
+
+func checkPercent(percent int) error {
+	if percent < 0 || percent > 100 {
+		return fmt.Errorf("%w: percent %d is outside 0..100", ErrInvalidRollout, percent)
+	}
+
+	return nil
+}
+
+func (c *Core) StartRollout(rollout *models.Rollout, actor *auth.Principal) (*models.Rollout, error) {
+	if rollout == nil || rollout.Project == "" || rollout.Version < 1 {
+		return nil, ErrNilInput
+	}
+
+	actor = principal(actor)
+
+	rollout.Environment = models.EnvironmentOrDefault(rollout.Environment)
+
+	if err := checkPercent(rollout.Percent); err != nil {
+		return nil, err
+	}
+
+	for _, step := range rollout.Steps {
+		if err := checkPercent(step.Percent); err != nil {
+			return nil, err
+		}
+	}
+
+	sort.Slice(rollout.Steps, func(i, j int) bool {
+		return rollout.Steps[i].At.Before(rollout.Steps[j].At)
+	})
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	if err := c.checkChange(ctx, rollout.Project, rollout.Environment, rollout.Version, AuditStartRollout, actor); err != nil {
+		return nil, err
+	}
+
+	chunk, err := c.storage.GetChunkByVersion(ctx, rollout.Project, rollout.Environment, rollout.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	if chunk.InUse {
+		return nil, fmt.Errorf("%w: version %d is already active", ErrInvalidRollout, rollout.Version)
+	}
+
+	if err = c.checkApproval(ctx, chunk); err != nil {
+		return nil, err
+	}
+
+	if _, err = c.checkRules(ctx, chunk, ActionRollOn); err != nil {
+		c.logger.Error("failed start rollout",
+			zap.String("project", rollout.Project),
+			zap.String("environment", rollout.Environment),
+			zap.Int("version", rollout.Version),
+			zap.Error(err))
+
+		return nil, err
+	}
+
+	if err = c.storage.SaveRollout(ctx, rollout); err != nil {
+		return nil, err
+	}
+
+	c.auditRollout(ctx, AuditStartRollout, rollout, actor)
+	c.publishRollout(rollout)
+
+	if rollout.Percent == 100 {
+		return rollout, c.PromoteRollout(rollout.Project, rollout.Environment, actor)
+	}
+
+	return rollout, nil
+}
+
+func (c *Core) GetRollout(project, environment string) (*models.Rollout, error) {
+	if project == "" {
+		return nil, ErrNilInput
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	return c.storage.GetRollout(ctx, project, models.EnvironmentOrDefault(environment))
+}
+
+// SetRolloutPercent moves a running rollout to percent. Reaching 100 promotes
+// the version for everyone.
+func (c *Core) SetRolloutPercent(project, environment string, percent int, actor *auth.Principal) (*models.Rollout, error) {
+	if project == "" {
+		return nil, ErrNilInput
+	}
+
+	actor = principal(actor)
+
+	if err := checkPercent(percent); err != nil {
+		return nil, err
+	}
+
+	environment = models.EnvironmentOrDefault(environment)
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	rollout, err := c.storage.GetRollout(ctx, project, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	return rollout, c.setRolloutPercent(ctx, rollout, percent, actor)
+}
+
+// setRolloutPercent stores rollout at percent together with any other change
+// the caller made to it.
+func (c *Core) setRolloutPercent(ctx context.Context, rollout *models.Rollout, percent int, actor *auth.Principal) error {
+	if err := c.checkChange(ctx, rollout.Project, rollout.Environment, rollout.Version, AuditSetRollout, actor); err != nil {
+		return err
+	}
+
+	rollout.Percent = percent
+
+	if err := c.storage.SaveRollout(ctx, rollout); err != nil {
+		return err
+	}
+
+	c.auditRollout(ctx, AuditSetRollout, rollout, actor)
+	c.publishRollout(rollout)
+
+	if percent == 100 {
+		return c.PromoteRollout(rollout.Project, rollout.Environment, actor)
+	}
+
+	return nil
+}
+
+// PromoteRollout activates the version under rollout for every client.
+func (c *Core) PromoteRollout(project, environment string, actor *auth.Principal) error {
+	actor = principal(actor)
+
+	rollout, err := c.GetRollout(project, environment)
+	if err != nil {
+		return err
+	}
+
+	if err = c.RollOn(rollout.Project, rollout.Environment, rollout.Version, actor, models.Precondition{}); err != nil {
+		return err
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	return c.storage.DeleteRollout(ctx, rollout.Project, rollout.Environment)
+}
+
+// AbortRollout sends every client back to the active version.
+func (c *Core) AbortRollout(project, environment string, actor *auth.Principal) error {
+	actor = principal(actor)
+
+	rollout, err := c.GetRollout(project, environment)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	if err = c.checkChange(ctx, rollout.Project, rollout.Environment, rollout.Version, AuditAbortRollout, actor); err != nil {
+		return err
+	}
+
+	if err = c.storage.DeleteRollout(ctx, rollout.Project, rollout.Environment); err != nil {
+		return err
+	}
+
+	rollout.Percent = 0
+	c.auditRollout(ctx, AuditAbortRollout, rollout, actor)
+	c.publishRollout(rollout)
+
+	return nil
+}
+
+func (c *Core) auditRollout(ctx context.Context, action string, rollout *models.Rollout, actor *auth.Principal) {
+	c.audit(ctx, models.AuditEntry{
+		Actor:       actor.Name,
+		Action:      action,
+		Project:     rollout.Project,
+		Environment: rollout.Environment,
+		Version:     rollout.Version,
+		Detail:      fmt.Sprintf("%d%%", rollout.Percent),
+	})
+}
+
+func (c *Core) publishRollout(rollout *models.Rollout) {
+	c.bus.Publish(events.Event{
+		Type:        events.RolloutChanged,
+		Project:     rollout.Project,
+		Environment: rollout.Environment,
+		Version:     rollout.Version,
+	})
+}
+
+// rolloutChunk returns the version under rollout when the client falls into
+// the rolled out share, and nil when it should get the active version.
+func (c *Core) rolloutChunk(ctx context.Context, project, environment, clientID string) (*models.Chunk, error) {
+	rollout, err := c.storage.GetRollout(ctx, project, environment)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if bucket(project, environment, clientID) >= rollout.Percent {
+		return nil, nil
+	}
+
+	chunk, err := c.storage.GetChunkByVersion(ctx, project, environment, rollout.Version)
+	if errors.Is(err, storage.ErrNotFound) {
+		// The version was deleted under the rollout; serve the active one.
+		c.logger.Warn("rollout version is gone",
+			zap.String("project", project),
+			zap.String("environment", environment),
+			zap.Int("version", rollout.Version))
+
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return c.build(ctx, chunk)
+}
+
+// dueSteps counts the steps of rollout whose time has come.
+func dueSteps(rollout *models.Rollout, now time.Time) int {
+	due := 0
+	for due < len(rollout.Steps) && !rollout.Steps[due].At.After(now) {
+		due++
+	}
+
+	return due
+}
+
+// advanceRollouts applies every due step of the rollouts this replica manages
+// to claim. The rollout is read again under the lease, so a step another
+// replica has just applied is not applied twice.
+func (c *Core) advanceRollouts(ctx context.Context, owner string) error {
+	rollouts, err := c.storage.ListRollouts(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+
+	for _, listed := range rollouts {
+		if dueSteps(&listed, now) == 0 {
+			continue
+		}
+
+		claimed, err := c.storage.ClaimRollout(ctx, listed.Project, listed.Environment, owner, now, ScheduleLease)
+		if err != nil {
+			return err
+		}
+
+		if !claimed {
+			continue
+		}
+
+		if err = c.advanceRollout(ctx, listed.Project, listed.Environment, now); err != nil {
+			c.logger.Error("failed advance rollout",
+				zap.String("project", listed.Project),
+				zap.String("environment", listed.Environment),
+				zap.Error(err))
+		}
+
+		if err = c.storage.ReleaseRollout(ctx, listed.Project, listed.Environment, owner); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Core) advanceRollout(ctx context.Context, project, environment string, now time.Time) error {
+	rollout, err := c.storage.GetRollout(ctx, project, environment)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	due := dueSteps(rollout, now)
+	if due == 0 {
+		return nil
+	}
+
+	percent := rollout.Steps[due-1].Percent
+	rollout.Steps = rollout.Steps[due:]
+
+	return c.setRolloutPercent(ctx, rollout, percent, system(ActorRollout))
+}
+
+// RunRollouts advances scheduled rollouts on every tick until ctx is done.
+func (c *Core) RunRollouts(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultRolloutInterval
+	}
+
+	owner := leaseOwner()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := c.advanceRollouts(ctx, owner); err != nil {
+				c.logger.Error("failed advance rollouts", zap.Error(err))
+			}
+		}
+	}
+}
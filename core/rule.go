@@ -0,0 +1,158 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/osamikoyo/yoconf/auth"
+	"github.com/osamikoyo/yoconf/models"
+	"github.com/osamikoyo/yoconf/policy"
+	"github.com/osamikoyo/yoconf/storage"
+	"go.uber.org/zap"
+)
+
+const (
+	ActionPublish = "publish"
+	ActionRollOn  = "rollon"
+
+	AuditSetRule    = "set_rule"
+	AuditDeleteRule = "delete_rule"
+)
+
+// SetRule creates or replaces a rule of a project. Rules guard what may go
+// live, so they take the admin permission and respect locks and freezes.
+func (c *Core) SetRule(rule *models.Rule, actor *auth.Principal) error {
+	if rule == nil || rule.Project == "" || rule.Name == "" || actor == nil {
+		return ErrNilInput
+	}
+
+	if !actor.Can(auth.PermissionAdmin) {
+		return fmt.Errorf("%w: %s may not change rules", ErrNotAuthorized, actor.Name)
+	}
+
+	var err error
+	if rule.Mode, err = policy.NormalizeMode(rule.Mode); err != nil {
+		return err
+	}
+
+	if _, err = policy.Compile(rule.Expression); err != nil {
+		return err
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	if err = c.checkChange(ctx, rule.Project, "", 0, AuditSetRule, actor); err != nil {
+		return err
+	}
+
+	if err = c.storage.SaveRule(ctx, rule); err != nil {
+		c.logger.Error("failed set rule",
+			zap.String("project", rule.Project),
+			zap.String("rule", rule.Name),
+			zap.Error(err))
+
+		return err
+	}
+
+	c.audit(ctx, models.AuditEntry{
+		Actor:   actor.Name,
+		Action:  AuditSetRule,
+		Project: rule.Project,
+		Detail:  fmt.Sprintf("%s (%s): %s", rule.Name, rule.Mode, rule.Expression),
+	})
+
+	return nil
+}
+
+func (c *Core) DeleteRule(project, name string, actor *auth.Principal) error {
+	if project == "" || name == "" || actor == nil {
+		return ErrNilInput
+	}
+
+	if !actor.Can(auth.PermissionAdmin) {
+		return fmt.Errorf("%w: %s may not change rules", ErrNotAuthorized, actor.Name)
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	if err := c.checkChange(ctx, project, "", 0, AuditDeleteRule, actor); err != nil {
+		return err
+	}
+
+	if err := c.storage.DeleteRule(ctx, project, name); err != nil {
+		return err
+	}
+
+	c.audit(ctx, models.AuditEntry{
+		Actor:   actor.Name,
+		Action:  AuditDeleteRule,
+		Project: project,
+		Detail:  name,
+	})
+
+	return nil
+}
+
+func (c *Core) ListRules(project string) ([]models.Rule, error) {
+	if project == "" {
+		return nil, ErrNilInput
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	return c.storage.ListRules(ctx, project)
+}
+
+// checkRules evaluates the project rules against a chunk that is about to
+// become active, next to the version it replaces.
+func (c *Core) checkRules(ctx context.Context, chunk *models.Chunk, action string) ([]policy.Result, error) {
+	rules, err := c.storage.ListRules(ctx, chunk.Project)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	config, err := parseData(chunk)
+	if err != nil {
+		return nil, err
+	}
+
+	input := policy.Input{
+		Config: config,
+		Meta: map[string]any{
+			"project":     chunk.Project,
+			"environment": chunk.Environment,
+			"version":     chunk.Version,
+			"author":      chunk.Author,
+			"format":      chunk.Format,
+			"action":      action,
+		},
+	}
+
+	previous, err := c.storage.GetChunk(ctx, chunk.Project, chunk.Environment)
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+	case err != nil:
+		return nil, err
+	default:
+		input.Previous, _ = parseData(previous)
+		input.Meta["previous_version"] = previous.Version
+	}
+
+	warnings, err := policy.Evaluate(rules, input)
+	for _, warning := range warnings {
+		c.logger.Warn("rule failed in warn mode",
+			zap.String("project", chunk.Project),
+			zap.String("rule", warning.Rule),
+			zap.String("message", warning.Message))
+	}
+
+	return warnings, err
+}
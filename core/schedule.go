@@ -0,0 +1,232 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/osamikoyo/yoconf/auth"
+	"github.com/osamikoyo/yoconf/events"
+	"github.com/osamikoyo/yoconf/models"
+	"go.uber.org/zap"
+)
+
+const (
+	ActorScheduler = "scheduler"
+
+	ScheduleLease           = time.Minute
+	DefaultScheduleInterval = time.Second
+)
+
+var ErrInvalidSchedule = errors.New("invalid schedule")
+
+// ScheduleRollOn plans the activation of a version for later on behalf of
+// actor. Locks and freezes are checked now and again when it runs.
+func (c *Core) ScheduleRollOn(schedule *models.Schedule, actor *auth.Principal) (*models.Schedule, error) {
+	if schedule == nil || schedule.Project == "" || schedule.Version < 1 {
+		return nil, ErrNilInput
+	}
+
+	actor = principal(actor)
+
+	if !schedule.At.After(time.Now()) {
+		return nil, fmt.Errorf("%w: %s is not in the future", ErrInvalidSchedule, schedule.At.Format(time.RFC3339))
+	}
+
+	schedule.Environment = models.EnvironmentOrDefault(schedule.Environment)
+	schedule.At = schedule.At.UTC()
+	schedule.Status = models.SchedulePending
+	schedule.Author = actor.Name
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	if err := c.checkChange(ctx, schedule.Project, schedule.Environment, schedule.Version, AuditSchedule, actor); err != nil {
+		return nil, err
+	}
+
+	chunk, err := c.storage.GetChunkByVersion(ctx, schedule.Project, schedule.Environment, schedule.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = c.checkApproval(ctx, chunk); err != nil {
+		return nil, err
+	}
+
+	if err = c.storage.CreateSchedule(ctx, schedule); err != nil {
+		return nil, err
+	}
+
+	c.audit(ctx, models.AuditEntry{
+		Actor:       schedule.Author,
+		Action:      AuditSchedule,
+		Project:     schedule.Project,
+		Environment: schedule.Environment,
+		Version:     schedule.Version,
+		Detail:      fmt.Sprintf("schedule %d at %s", schedule.ID, schedule.At.Format(time.RFC3339)),
+	})
+
+	c.bus.Publish(events.Event{
+		Type:        events.Scheduled,
+		Project:     schedule.Project,
+		Environment: schedule.Environment,
+		Version:     schedule.Version,
+		Actor:       schedule.Author,
+	})
+
+	return schedule, nil
+}
+
+func (c *Core) ListSchedules(project, environment string) ([]models.Schedule, error) {
+	if project == "" {
+		return nil, ErrNilInput
+	}
+
+	if environment != "" {
+		environment = models.EnvironmentOrDefault(environment)
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	return c.storage.ListSchedules(ctx, project, environment)
+}
+
+// CancelSchedule drops a schedule that has not started running yet.
+func (c *Core) CancelSchedule(id uint, actor *auth.Principal) error {
+	actor = principal(actor)
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	schedule, err := c.storage.GetSchedule(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err = c.checkChange(ctx, schedule.Project, schedule.Environment, schedule.Version, AuditCancelSchedule, actor); err != nil {
+		return err
+	}
+
+	cancelled, err := c.storage.SetScheduleStatus(ctx, id, models.SchedulePending, models.ScheduleCancelled)
+	if err != nil {
+		return err
+	}
+
+	if !cancelled {
+		return fmt.Errorf("%w: schedule %d is %s", ErrInvalidSchedule, id, schedule.Status)
+	}
+
+	c.audit(ctx, models.AuditEntry{
+		Actor:       actor.Name,
+		Action:      AuditCancelSchedule,
+		Project:     schedule.Project,
+		Environment: schedule.Environment,
+		Version:     schedule.Version,
+		Detail:      fmt.Sprintf("schedule %d", id),
+	})
+
+	c.bus.Publish(events.Event{
+		Type:        events.ScheduleCancelled,
+		Project:     schedule.Project,
+		Environment: schedule.Environment,
+		Version:     schedule.Version,
+		Actor:       actor.Name,
+	})
+
+	return nil
+}
+
+// leaseOwner names this replica when it takes a lease.
+func leaseOwner() string {
+	hostname, _ := os.Hostname()
+
+	return fmt.Sprintf("%s-%d-%d", hostname, os.Getpid(), time.Now().UnixNano())
+}
+
+// runDueSchedules activates every due schedule this replica manages to claim.
+func (c *Core) runDueSchedules(ctx context.Context, owner string) error {
+	now := time.Now().UTC()
+
+	due, err := c.storage.DueSchedules(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	for _, schedule := range due {
+		claimed, err := c.storage.ClaimSchedule(ctx, schedule.ID, owner, now, ScheduleLease)
+		if err != nil {
+			return err
+		}
+
+		if !claimed {
+			continue
+		}
+
+		err = c.activate(schedule.Project, schedule.Environment, schedule.Version, system(ActorScheduler), models.Precondition{}, func(ctx context.Context) error {
+			return c.storage.RunSchedule(ctx, &schedule, owner)
+		})
+		if errors.Is(err, ErrConflict) {
+			// Another replica took the lease over and runs the schedule.
+			continue
+		}
+
+		if err != nil {
+			c.logger.Error("failed run schedule",
+				zap.Uint("id", schedule.ID),
+				zap.String("project", schedule.Project),
+				zap.String("environment", schedule.Environment),
+				zap.Int("version", schedule.Version),
+				zap.Error(err))
+
+			c.audit(ctx, models.AuditEntry{
+				Actor:       ActorScheduler,
+				Action:      AuditScheduleFailed,
+				Project:     schedule.Project,
+				Environment: schedule.Environment,
+				Version:     schedule.Version,
+				Detail:      fmt.Sprintf("schedule %d: %v", schedule.ID, err),
+			})
+
+			c.bus.Publish(events.Event{
+				Type:        events.ScheduleFailed,
+				Project:     schedule.Project,
+				Environment: schedule.Environment,
+				Version:     schedule.Version,
+				Actor:       ActorScheduler,
+			})
+
+			if err = c.storage.FinishSchedule(ctx, schedule.ID, owner, models.ScheduleFailed, err.Error()); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// RunSchedules runs due schedules on every tick until ctx is done.
+func (c *Core) RunSchedules(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultScheduleInterval
+	}
+
+	owner := leaseOwner()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := c.runDueSchedules(ctx, owner); err != nil {
+				c.logger.Error("failed run schedules", zap.Error(err))
+			}
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/osamikoyo/yoconf/auth"
+	"github.com/osamikoyo/yoconf/models"
+	"github.com/osamikoyo/yoconf/schema"
+	"go.uber.org/zap"
+)
+
+const AuditSetSchema = "set_schema"
+
+// SetSchema stores a new schema version for a project and makes it current.
+// An empty source detaches the schema. Schemas guard what may be published,
+// so they take the admin permission and respect locks and freezes.
+func (c *Core) SetSchema(name, source string, actor *auth.Principal) (*models.Schema, error) {
+	if name == "" || actor == nil {
+		return nil, ErrNilInput
+	}
+
+	if !actor.Can(auth.PermissionAdmin) {
+		return nil, fmt.Errorf("%w: %s may not change schemas", ErrNotAuthorized, actor.Name)
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	if err := c.checkChange(ctx, name, "", 0, AuditSetSchema, actor); err != nil {
+		return nil, err
+	}
+
+	project, err := c.project(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if source == "" {
+		project.SchemaVersion = 0
+
+		if err = c.storage.SaveProject(ctx, project); err != nil {
+			return nil, err
+		}
+
+		c.audit(ctx, models.AuditEntry{
+			Actor:   actor.Name,
+			Action:  AuditSetSchema,
+			Project: name,
+			Detail:  "detached",
+		})
+
+		return &models.Schema{Project: name}, nil
+	}
+
+	if _, err = schema.Compile(source); err != nil {
+		return nil, err
+	}
+
+	created := &models.Schema{
+		Project: name,
+		Data:    source,
+	}
+
+	if err = c.storage.CreateSchema(ctx, created); err != nil {
+		c.logger.Error("failed set schema",
+			zap.String("project", name),
+			zap.Error(err))
+
+		return nil, err
+	}
+
+	project.SchemaVersion = created.Version
+	if err = c.storage.SaveProject(ctx, project); err != nil {
+		c.logger.Error("failed set schema",
+			zap.String("project", name),
+			zap.Error(err))
+
+		return nil, err
+	}
+
+	c.audit(ctx, models.AuditEntry{
+		Actor:   actor.Name,
+		Action:  AuditSetSchema,
+		Project: name,
+		Detail:  fmt.Sprintf("version %d", created.Version),
+	})
+
+	return created, nil
+}
+
+// GetSchema returns a schema version of a project, the current one for version 0.
+func (c *Core) GetSchema(name string, version int) (*models.Schema, error) {
+	if name == "" {
+		return nil, ErrNilInput
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	if version == 0 {
+		project, err := c.project(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+
+		if project.SchemaVersion == 0 {
+			return &models.Schema{Project: name}, nil
+		}
+
+		version = project.SchemaVersion
+	}
+
+	return c.storage.GetSchema(ctx, name, version)
+}
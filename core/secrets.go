@@ -0,0 +1,39 @@
+package core
+
+import (
+	"github.com/osamikoyo/yoconf/auth"
+	"github.com/osamikoyo/yoconf/models"
+	"github.com/osamikoyo/yoconf/secrets"
+)
+
+// present returns chunk as the principal may see it: secrets are decrypted
+// for callers holding the secret read permission and masked for everyone else.
+// Data that no longer matches its publish signature, because it was masked
+// or built from layers and includes, is signed again as served, so clients
+// can verify what they receive.
+func (c *Core) present(chunk *models.Chunk, principal *auth.Principal) (*models.Chunk, error) {
+	result := *chunk
+
+	switch {
+	case !secrets.HasSecrets(chunk.Data):
+	case !principal.Can(auth.PermissionSecretRead):
+		result.Data = secrets.Mask(chunk.Data)
+		result.Signature = ""
+		result.SigningKey = ""
+	default:
+		data, err := c.sealer.Reveal(chunk.Data)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Data = data
+	}
+
+	if result.Signature == "" {
+		if err := c.signer.Sign(&result); err != nil {
+			return nil, err
+		}
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,152 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/osamikoyo/yoconf/auth"
+	"github.com/osamikoyo/yoconf/format"
+	"github.com/osamikoyo/yoconf/merge"
+	"github.com/osamikoyo/yoconf/models"
+	"github.com/osamikoyo/yoconf/storage"
+	"go.uber.org/zap"
+)
+
+// SpringRequest is a Spring Cloud Config read: the application is the
+// project, every profile an environment and the label a version number. Any
+// other label, such as the main or master branch clients send by default,
+// stands for the active version.
+type SpringRequest struct {
+	Application string
+	Profiles    []string
+	Label       string
+	Principal   *auth.Principal
+}
+
+// springChunks reads the application in every profile, skipping profiles
+// without config the way a config server skips missing files. The result
+// follows the profile order, so later chunks win.
+func (c *Core) springChunks(req SpringRequest) ([]*models.Chunk, error) {
+	if req.Application == "" || len(req.Profiles) == 0 {
+		return nil, ErrNilInput
+	}
+
+	chunks := []*models.Chunk{}
+
+	for _, profile := range req.Profiles {
+		chunk, err := c.springChunk(req.Application, profile, req.Label, req.Principal)
+		if errors.Is(err, storage.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			c.logger.Error("failed read spring profile",
+				zap.String("application", req.Application),
+				zap.String("profile", profile),
+				zap.String("label", req.Label),
+				zap.Error(err))
+
+			return nil, err
+		}
+
+		chunks = append(chunks, chunk)
+	}
+
+	return chunks, nil
+}
+
+func (c *Core) springChunk(project, environment, label string, principal *auth.Principal) (*models.Chunk, error) {
+	environment = models.EnvironmentOrDefault(environment)
+
+	if _, err := strconv.Atoi(label); err != nil {
+		return c.GetConfig(ReadRequest{
+			Project:     project,
+			Environment: environment,
+			Principal:   principal,
+		})
+	}
+
+	chunk, err := c.resolveChunk(project, environment, label)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	if chunk, err = c.build(ctx, chunk); err != nil {
+		return nil, err
+	}
+
+	return c.present(chunk, principal)
+}
+
+// SpringEnvironment returns the property sources of an application, the most
+// specific profile first as Spring expects.
+func (c *Core) SpringEnvironment(req SpringRequest) (*models.SpringEnvironment, error) {
+	chunks, err := c.springChunks(req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &models.SpringEnvironment{
+		Name:            req.Application,
+		Profiles:        req.Profiles,
+		PropertySources: []models.PropertySource{},
+	}
+
+	if req.Label != "" {
+		result.Label = &req.Label
+	}
+
+	for i := len(chunks) - 1; i >= 0; i-- {
+		chunk := chunks[i]
+
+		value, err := parseData(chunk)
+		if err != nil {
+			return nil, err
+		}
+
+		source, err := format.Flatten(value)
+		if err != nil {
+			return nil, err
+		}
+
+		if result.Version == nil {
+			version := strconv.Itoa(chunk.Version)
+			result.Version = &version
+		}
+
+		result.PropertySources = append(result.PropertySources, models.PropertySource{
+			Name:   fmt.Sprintf("yoconf:%s/%s@%d", chunk.Project, chunk.Environment, chunk.Version),
+			Source: source,
+		})
+	}
+
+	return result, nil
+}
+
+// SpringDocument returns the application in all its profiles merged into one
+// document, for the .yml and .properties variants of the API.
+func (c *Core) SpringDocument(req SpringRequest) (any, error) {
+	chunks, err := c.springChunks(req)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]any, 0, len(chunks))
+	for _, chunk := range chunks {
+		value, err := parseData(chunk)
+		if err != nil {
+			return nil, err
+		}
+
+		values = append(values, value)
+	}
+
+	if len(values) == 0 {
+		return map[string]any{}, nil
+	}
+
+	return merge.All(values...), nil
+}
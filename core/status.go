@@ -0,0 +1,80 @@
+package core
+
+import (
+	"errors"
+	"time"
+
+	"github.com/osamikoyo/yoconf/freeze"
+	"github.com/osamikoyo/yoconf/models"
+	"github.com/osamikoyo/yoconf/storage"
+)
+
+func (c *Core) ProjectStatus(project string) (*models.ProjectStatus, error) {
+	if project == "" {
+		return nil, ErrNilInput
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	environments, err := c.storage.ListEnvironments(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &models.ProjectStatus{
+		Project:      project,
+		Environments: make([]models.EnvironmentStatus, 0, len(environments)),
+	}
+
+	now := time.Now().UTC()
+
+	if status.Lock, err = c.storage.GetLock(ctx, project, now); err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return nil, err
+	}
+
+	windows, err := c.storage.ListFreezeWindows(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range windows {
+		if _, ok := freeze.Active(&windows[i], now); ok {
+			status.Freezes = append(status.Freezes, windows[i])
+		}
+	}
+
+	for _, environment := range environments {
+		env := models.EnvironmentStatus{Environment: environment}
+
+		active, err := c.storage.GetChunk(ctx, project, environment)
+		if err == nil {
+			env.ActiveVersion = active.Version
+		} else if !errors.Is(err, storage.ErrNotFound) {
+			return nil, err
+		}
+
+		if env.Override, err = c.storage.GetOverride(ctx, project, environment); err != nil && !errors.Is(err, storage.ErrNotFound) {
+			return nil, err
+		}
+
+		if env.Rollout, err = c.storage.GetRollout(ctx, project, environment); err != nil && !errors.Is(err, storage.ErrNotFound) {
+			return nil, err
+		}
+
+		schedules, err := c.storage.ListSchedules(ctx, project, environment)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, schedule := range schedules {
+			if schedule.Status == models.SchedulePending || schedule.Status == models.ScheduleRunning {
+				env.Schedules = append(env.Schedules, schedule)
+			}
+		}
+
+		status.Environments = append(status.Environments, env)
+	}
+
+	return status, nil
+}
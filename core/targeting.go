@@ -0,0 +1,200 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/osamikoyo/yoconf/auth"
+	"github.com/osamikoyo/yoconf/events"
+	"github.com/osamikoyo/yoconf/models"
+	"github.com/osamikoyo/yoconf/selector"
+	"github.com/osamikoyo/yoconf/storage"
+	"go.uber.org/zap"
+)
+
+const AuditSetTargeting = "set_targeting"
+
+var ErrInvalidTargeting = errors.New("invalid targeting")
+
+// SetTargeting replaces the targeting rules of an environment. The rules
+// decide which version clients are served, so they respect locks and freezes
+// like an activation.
+func (c *Core) SetTargeting(targeting *models.Targeting, actor *auth.Principal) error {
+	if targeting == nil || targeting.Project == "" {
+		return ErrNilInput
+	}
+
+	actor = principal(actor)
+
+	targeting.Environment = models.EnvironmentOrDefault(targeting.Environment)
+
+	versions := []int{}
+	if targeting.DefaultVersion != 0 {
+		versions = append(versions, targeting.DefaultVersion)
+	}
+
+	for _, rule := range targeting.Rules {
+		if _, err := selector.Parse(rule.Selector); err != nil {
+			return fmt.Errorf("%w: rule %q: %v", ErrInvalidTargeting, rule.Name, err)
+		}
+
+		versions = append(versions, rule.Version)
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	if err := c.checkChange(ctx, targeting.Project, targeting.Environment, targeting.DefaultVersion, AuditSetTargeting, actor); err != nil {
+		return err
+	}
+
+	for _, version := range versions {
+		if version < 1 {
+			return fmt.Errorf("%w: version %d", ErrInvalidTargeting, version)
+		}
+
+		chunk, err := c.storage.GetChunkByVersion(ctx, targeting.Project, targeting.Environment, version)
+		if err != nil {
+			return err
+		}
+
+		if err = c.checkApproval(ctx, chunk); err != nil {
+			return err
+		}
+
+		if _, err = c.checkRules(ctx, chunk, ActionRollOn); err != nil {
+			c.logger.Error("failed set targeting",
+				zap.String("project", targeting.Project),
+				zap.String("environment", targeting.Environment),
+				zap.Int("version", version),
+				zap.Error(err))
+
+			return err
+		}
+	}
+
+	if err := c.storage.SaveTargeting(ctx, targeting); err != nil {
+		return err
+	}
+
+	c.audit(ctx, models.AuditEntry{
+		Actor:       actor.Name,
+		Action:      AuditSetTargeting,
+		Project:     targeting.Project,
+		Environment: targeting.Environment,
+		Version:     targeting.DefaultVersion,
+		Detail:      fmt.Sprintf("%d rules", len(targeting.Rules)),
+	})
+
+	c.bus.Publish(events.Event{
+		Type:        events.TargetingChanged,
+		Project:     targeting.Project,
+		Environment: targeting.Environment,
+	})
+
+	return nil
+}
+
+func (c *Core) GetTargeting(project, environment string) (*models.Targeting, error) {
+	if project == "" {
+		return nil, ErrNilInput
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	return c.storage.GetTargeting(ctx, project, models.EnvironmentOrDefault(environment))
+}
+
+// evaluate walks the rules in order. A zero version in the result stands for
+// the active version.
+func evaluate(targeting *models.Targeting, labels map[string]string) models.TargetingResult {
+	for _, rule := range targeting.Rules {
+		sel, err := selector.Parse(rule.Selector)
+		if err != nil {
+			continue
+		}
+
+		if sel.Matches(labels) {
+			return models.TargetingResult{
+				Rule:    rule.Name,
+				Version: rule.Version,
+			}
+		}
+	}
+
+	return models.TargetingResult{
+		Version: targeting.DefaultVersion,
+		Default: true,
+	}
+}
+
+// EvaluateTargeting reports which rule and version a client with labels gets.
+func (c *Core) EvaluateTargeting(project, environment string, labels map[string]string) (*models.TargetingResult, error) {
+	if project == "" {
+		return nil, ErrNilInput
+	}
+
+	environment = models.EnvironmentOrDefault(environment)
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	result := models.TargetingResult{Default: true}
+
+	targeting, err := c.storage.GetTargeting(ctx, project, environment)
+	if err == nil {
+		result = evaluate(targeting, labels)
+	} else if !errors.Is(err, storage.ErrNotFound) {
+		return nil, err
+	}
+
+	if result.Version == 0 {
+		active, err := c.storage.GetChunk(ctx, project, environment)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Version = active.Version
+	}
+
+	return &result, nil
+}
+
+// targetedChunk returns the version pinned to a client by its labels, and nil
+// when the client should get the active version.
+func (c *Core) targetedChunk(ctx context.Context, project, environment string, labels map[string]string) (*models.Chunk, error) {
+	targeting, err := c.storage.GetTargeting(ctx, project, environment)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := evaluate(targeting, labels)
+	if result.Version == 0 {
+		return nil, nil
+	}
+
+	chunk, err := c.storage.GetChunkByVersion(ctx, project, environment, result.Version)
+	if errors.Is(err, storage.ErrNotFound) {
+		// The pinned version was deleted; serve the active one.
+		c.logger.Warn("targeted version is gone",
+			zap.String("project", project),
+			zap.String("environment", environment),
+			zap.Int("version", result.Version))
+
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if chunk.InUse {
+		return nil, nil
+	}
+
+	return c.build(ctx, chunk)
+}
@@ -0,0 +1,97 @@
+package core
+
+import (
+	"context"
+
+	"github.com/osamikoyo/yoconf/format"
+	"github.com/osamikoyo/yoconf/models"
+	"github.com/osamikoyo/yoconf/policy"
+	"github.com/osamikoyo/yoconf/schema"
+)
+
+func (c *Core) validate(ctx context.Context, chunk *models.Chunk) ([]policy.Result, error) {
+	project, err := c.project(ctx, chunk.Project)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = checkFormat(chunk, project); err != nil {
+		return nil, err
+	}
+
+	if err = c.checkSchema(ctx, chunk, project); err != nil {
+		return nil, err
+	}
+
+	if err = checkFlags(chunk, project); err != nil {
+		return nil, err
+	}
+
+	return c.checkRules(ctx, chunk, ActionPublish)
+}
+
+// checkFormat fills in the project format for chunks that do not declare one
+// and makes sure the data parses in it.
+func checkFormat(chunk *models.Chunk, project *models.Project) error {
+	var err error
+	if chunk.Format, err = format.Normalize(chunk.Format); err != nil {
+		return err
+	}
+
+	if chunk.Format == "" {
+		chunk.Format = project.Format
+	}
+
+	if chunk.Format == "" {
+		return nil
+	}
+
+	return format.Validate(chunk.Format, chunk.Data)
+}
+
+func parseData(chunk *models.Chunk) (any, error) {
+	if chunk.Format == "" {
+		return format.Parse(format.YAML, chunk.Data)
+	}
+
+	return format.Parse(chunk.Format, chunk.Data)
+}
+
+func (c *Core) checkSchema(ctx context.Context, chunk *models.Chunk, project *models.Project) error {
+	if project.SchemaVersion == 0 {
+		return nil
+	}
+
+	current, err := c.storage.GetSchema(ctx, project.Name, project.SchemaVersion)
+	if err != nil {
+		return err
+	}
+
+	value, err := parseData(chunk)
+	if err != nil {
+		return err
+	}
+
+	if err = schema.Validate(current.Data, value); err != nil {
+		return err
+	}
+
+	chunk.SchemaVersion = current.Version
+
+	return nil
+}
+
+// Validate runs every publish check against a chunk without storing it and
+// returns the warnings of rules in warn mode.
+func (c *Core) Validate(chunk *models.Chunk) ([]policy.Result, error) {
+	if chunk == nil {
+		return nil, ErrNilInput
+	}
+
+	chunk.Environment = models.EnvironmentOrDefault(chunk.Environment)
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	return c.validate(ctx, chunk)
+}
@@ -0,0 +1,147 @@
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/osamikoyo/yoconf/models"
+	"gopkg.in/yaml.v3"
+)
+
+// Parse decodes YAML or JSON data into plain maps and slices. Only documents
+// with a map or list at the root are treated as structured.
+func Parse(data string) (any, bool) {
+	var value any
+	if err := yaml.Unmarshal([]byte(data), &value); err != nil {
+		return nil, false
+	}
+
+	switch value.(type) {
+	case map[string]any, []any:
+		return value, true
+	default:
+		return nil, false
+	}
+}
+
+// Structural returns the key-level changes between two configs, or false when
+// either side is not structured data.
+func Structural(from, to string) ([]models.Change, bool) {
+	a, ok := Parse(from)
+	if !ok {
+		return nil, false
+	}
+
+	b, ok := Parse(to)
+	if !ok {
+		return nil, false
+	}
+
+	changes := []models.Change{}
+	compare("", a, b, &changes)
+
+	return changes, true
+}
+
+func joinKey(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
+
+func encode(value any) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+
+	return string(data)
+}
+
+func compare(path string, a, b any, changes *[]models.Change) {
+	switch av := a.(type) {
+	case map[string]any:
+		bv, ok := b.(map[string]any)
+		if !ok {
+			break
+		}
+
+		keys := make([]string, 0, len(av)+len(bv))
+		for key := range av {
+			keys = append(keys, key)
+		}
+		for key := range bv {
+			if _, ok := av[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			next := joinKey(path, key)
+
+			old, inA := av[key]
+			cur, inB := bv[key]
+
+			switch {
+			case !inB:
+				*changes = append(*changes, models.Change{
+					Path: next,
+					Type: models.ChangeRemoved,
+					Old:  encode(old),
+				})
+			case !inA:
+				*changes = append(*changes, models.Change{
+					Path: next,
+					Type: models.ChangeAdded,
+					New:  encode(cur),
+				})
+			default:
+				compare(next, old, cur, changes)
+			}
+		}
+
+		return
+	case []any:
+		bv, ok := b.([]any)
+		if !ok {
+			break
+		}
+
+		for i := 0; i < max(len(av), len(bv)); i++ {
+			next := fmt.Sprintf("%s[%d]", path, i)
+
+			switch {
+			case i >= len(bv):
+				*changes = append(*changes, models.Change{
+					Path: next,
+					Type: models.ChangeRemoved,
+					Old:  encode(av[i]),
+				})
+			case i >= len(av):
+				*changes = append(*changes, models.Change{
+					Path: next,
+					Type: models.ChangeAdded,
+					New:  encode(bv[i]),
+				})
+			default:
+				compare(next, av[i], bv[i], changes)
+			}
+		}
+
+		return
+	}
+
+	if !reflect.DeepEqual(a, b) {
+		*changes = append(*changes, models.Change{
+			Path: path,
+			Type: models.ChangeChanged,
+			Old:  encode(a),
+			New:  encode(b),
+		})
+	}
+}
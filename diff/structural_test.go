@@ -0,0 +1,37 @@
+package diff
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/osamikoyo/yoconf/models"
+)
+
+func TestStructural(t *testing.T) {
+	from := "db:\n  host: a\n  port: 5432\nhosts: [x, y]\nold: true\n"
+	to := "db:\n  host: b\n  port: 5432\nhosts: [x]\nnew: {a: 1}\n"
+
+	want := []models.Change{
+		{Path: "db.host", Type: models.ChangeChanged, Old: `"a"`, New: `"b"`},
+		{Path: "hosts[1]", Type: models.ChangeRemoved, Old: `"y"`},
+		{Path: "new", Type: models.ChangeAdded, New: `{"a":1}`},
+		{Path: "old", Type: models.ChangeRemoved, Old: `true`},
+	}
+
+	got, ok := Structural(from, to)
+	if !ok {
+		t.Fatal("Structural() is not structured")
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Structural() = %+v, want %+v", got, want)
+	}
+
+	if got, _ := Structural(from, from); len(got) != 0 {
+		t.Errorf("Structural() of equal configs = %+v", got)
+	}
+
+	if _, ok := Structural("plain text", to); ok {
+		t.Error("Structural() of a scalar document is structured")
+	}
+}
@@ -0,0 +1,267 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+)
+
+const ContextLines = 3
+
+type opKind int
+
+const (
+	opEqual opKind = iota
+	opDelete
+	opInsert
+)
+
+type op struct {
+	kind opKind
+	a, b int
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return lines
+}
+
+// maxCost bounds the number of edits searched for between two ranges. Ranges
+// that differ by more are written as a delete of one followed by an insert
+// of the other, so unrelated texts cost linear time rather than quadratic.
+const maxCost = 1024
+
+// edits computes the shortest edit script between a and b with the linear
+// space variant of the Myers algorithm.
+func edits(a, b []string) []op {
+	d := &differ{a: a, b: b, ops: make([]op, 0, max(len(a), len(b)))}
+	d.compare(0, len(a), 0, len(b))
+
+	return d.ops
+}
+
+type differ struct {
+	a, b []string
+	ops  []op
+}
+
+func (d *differ) equal(x, y, count int) {
+	for i := 0; i < count; i++ {
+		d.ops = append(d.ops, op{kind: opEqual, a: x + i, b: y + i})
+	}
+}
+
+// replace writes a[aLo:aHi] as deleted and b[bLo:bHi] as inserted.
+func (d *differ) replace(aLo, aHi, bLo, bHi int) {
+	for x := aLo; x < aHi; x++ {
+		d.ops = append(d.ops, op{kind: opDelete, a: x, b: bLo})
+	}
+
+	for y := bLo; y < bHi; y++ {
+		d.ops = append(d.ops, op{kind: opInsert, a: aHi, b: y})
+	}
+}
+
+// compare appends the edit script of a[aLo:aHi] against b[bLo:bHi], splitting
+// the ranges at their middle snake until one side is empty.
+func (d *differ) compare(aLo, aHi, bLo, bHi int) {
+	prefix := 0
+	for aLo+prefix < aHi && bLo+prefix < bHi && d.a[aLo+prefix] == d.b[bLo+prefix] {
+		prefix++
+	}
+
+	d.equal(aLo, bLo, prefix)
+	aLo, bLo = aLo+prefix, bLo+prefix
+
+	suffix := 0
+	for aHi-suffix > aLo && bHi-suffix > bLo && d.a[aHi-suffix-1] == d.b[bHi-suffix-1] {
+		suffix++
+	}
+
+	aHi, bHi = aHi-suffix, bHi-suffix
+
+	if aLo == aHi || bLo == bHi {
+		d.replace(aLo, aHi, bLo, bHi)
+	} else if x, y, u, v, ok := d.middle(aLo, aHi, bLo, bHi); ok {
+		d.compare(aLo, x, bLo, y)
+		d.equal(x, y, u-x)
+		d.compare(u, aHi, v, bHi)
+	} else {
+		d.replace(aLo, aHi, bLo, bHi)
+	}
+
+	d.equal(aHi, bHi, suffix)
+}
+
+// middle finds the snake in the middle of a shortest edit script by running
+// the Myers search forwards from the start and backwards from the end of the
+// ranges until the two meet. It returns the snake as (x, y) to (u, v), or
+// false once the search passes maxCost.
+func (d *differ) middle(aLo, aHi, bLo, bHi int) (x, y, u, v int, ok bool) {
+	n, m := aHi-aLo, bHi-bLo
+	delta := n - m
+	odd := delta%2 != 0
+
+	limit := min((n+m+1)/2, maxCost)
+	offset := limit + 1
+
+	// forward[k] is the furthest x reached on diagonal x-y = k from the start,
+	// backward[c] the furthest distance reached on diagonal c from the end.
+	forward := make([]int, 2*limit+3)
+	backward := make([]int, 2*limit+3)
+
+	for cost := 0; cost <= limit; cost++ {
+		for k := -cost; k <= cost; k += 2 {
+			var fx int
+			if k == -cost || (k != cost && forward[offset+k-1] < forward[offset+k+1]) {
+				fx = forward[offset+k+1]
+			} else {
+				fx = forward[offset+k-1] + 1
+			}
+
+			fy := fx - k
+			sx, sy := fx, fy
+			for fx < n && fy < m && d.a[aLo+fx] == d.b[bLo+fy] {
+				fx++
+				fy++
+			}
+
+			forward[offset+k] = fx
+
+			if c := delta - k; odd && c >= -(cost-1) && c <= cost-1 && fx >= n-backward[offset+c] {
+				return aLo + sx, bLo + sy, aLo + fx, bLo + fy, true
+			}
+		}
+
+		for c := -cost; c <= cost; c += 2 {
+			var rx int
+			if c == -cost || (c != cost && backward[offset+c-1] < backward[offset+c+1]) {
+				rx = backward[offset+c+1]
+			} else {
+				rx = backward[offset+c-1] + 1
+			}
+
+			ry := rx - c
+			sx, sy := rx, ry
+			for rx < n && ry < m && d.a[aHi-rx-1] == d.b[bHi-ry-1] {
+				rx++
+				ry++
+			}
+
+			backward[offset+c] = rx
+
+			if k := delta - c; !odd && k >= -cost && k <= cost && forward[offset+k] >= n-rx {
+				return aHi - rx, bHi - ry, aHi - sx, bHi - sy, true
+			}
+		}
+	}
+
+	return 0, 0, 0, 0, false
+}
+
+func hunkRange(start, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
+
+func writeLine(sb *strings.Builder, prefix byte, line string) {
+	sb.WriteByte(prefix)
+	sb.WriteString(line)
+	if !strings.HasSuffix(line, "\n") {
+		sb.WriteString("\n\\ No newline at end of file\n")
+	}
+}
+
+// Unified renders a unified diff of two texts, returning an empty string when they are equal.
+func Unified(fromName, toName, from, to string) string {
+	a, b := splitLines(from), splitLines(to)
+	ops := edits(a, b)
+
+	changed := false
+	for _, o := range ops {
+		if o.kind != opEqual {
+			changed = true
+			break
+		}
+	}
+	if !changed {
+		return ""
+	}
+
+	sb := strings.Builder{}
+	fmt.Fprintf(&sb, "--- %s\n+++ %s\n", fromName, toName)
+
+	for i := 0; i < len(ops); {
+		for i < len(ops) && ops[i].kind == opEqual {
+			i++
+		}
+		if i == len(ops) {
+			break
+		}
+
+		start := max(i-ContextLines, 0)
+		end := i
+		for end < len(ops) {
+			if ops[end].kind != opEqual {
+				end++
+				continue
+			}
+
+			run := end
+			for run < len(ops) && ops[run].kind == opEqual {
+				run++
+			}
+			if run == len(ops) || run-end > 2*ContextLines {
+				end = min(end+ContextLines, run)
+				break
+			}
+
+			end = run
+		}
+
+		hunk := ops[start:end]
+
+		aStart, bStart := hunk[0].a, hunk[0].b
+		aCount, bCount := 0, 0
+		for _, o := range hunk {
+			switch o.kind {
+			case opEqual:
+				aCount++
+				bCount++
+			case opDelete:
+				aCount++
+			case opInsert:
+				bCount++
+			}
+		}
+		fmt.Fprintf(&sb, "@@ -%s +%s @@\n", hunkRange(aStart, aCount), hunkRange(bStart, bCount))
+
+		for _, o := range hunk {
+			switch o.kind {
+			case opEqual:
+				writeLine(&sb, ' ', a[o.a])
+			case opDelete:
+				writeLine(&sb, '-', a[o.a])
+			case opInsert:
+				writeLine(&sb, '+', b[o.b])
+			}
+		}
+
+		i = end
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,164 @@
+package diff
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+// apply rebuilds b from a and an edit script, failing on scripts that do not
+// walk both texts in order.
+func apply(t *testing.T, a, b []string, ops []op) []string {
+	t.Helper()
+
+	result := []string{}
+	x, y := 0, 0
+
+	for _, o := range ops {
+		switch o.kind {
+		case opEqual:
+			if o.a != x || o.b != y || a[o.a] != b[o.b] {
+				t.Fatalf("equal %d/%d at %d/%d", o.a, o.b, x, y)
+			}
+
+			result = append(result, a[o.a])
+			x++
+			y++
+		case opDelete:
+			if o.a != x {
+				t.Fatalf("delete %d at %d", o.a, x)
+			}
+
+			x++
+		case opInsert:
+			if o.b != y {
+				t.Fatalf("insert %d at %d", o.b, y)
+			}
+
+			result = append(result, b[o.b])
+			y++
+		}
+	}
+
+	if x != len(a) || y != len(b) {
+		t.Fatalf("script ends at %d/%d of %d/%d", x, y, len(a), len(b))
+	}
+
+	return result
+}
+
+// distance is the edit distance of a and b without substitutions, computed
+// by dynamic programming.
+func distance(a, b []string) int {
+	prev := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		cur := make([]int, len(b)+1)
+		cur[0] = i
+
+		for j := 1; j <= len(b); j++ {
+			if a[i-1] == b[j-1] {
+				cur[j] = prev[j-1]
+			} else {
+				cur[j] = min(prev[j], cur[j-1]) + 1
+			}
+		}
+
+		prev = cur
+	}
+
+	return prev[len(b)]
+}
+
+func cost(ops []op) int {
+	count := 0
+	for _, o := range ops {
+		if o.kind != opEqual {
+			count++
+		}
+	}
+
+	return count
+}
+
+func TestEditsShortest(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	alphabet := []string{"a", "b", "c", "d"}
+
+	text := func() []string {
+		lines := make([]string, random.Intn(12))
+		for i := range lines {
+			lines[i] = alphabet[random.Intn(len(alphabet))]
+		}
+
+		return lines
+	}
+
+	for i := 0; i < 2000; i++ {
+		a, b := text(), text()
+		ops := edits(a, b)
+
+		if got := strings.Join(apply(t, a, b, ops), ""); got != strings.Join(b, "") {
+			t.Fatalf("%v -> %v: script builds %q", a, b, got)
+		}
+
+		if got, want := cost(ops), distance(a, b); got != want {
+			t.Fatalf("%v -> %v: %d edits, want %d", a, b, got, want)
+		}
+	}
+}
+
+func TestEditsUnrelated(t *testing.T) {
+	a := make([]string, 10000)
+	b := make([]string, 10000)
+	for i := range a {
+		a[i] = fmt.Sprintf("a%d\n", i)
+		b[i] = fmt.Sprintf("b%d\n", i)
+	}
+
+	ops := edits(a, b)
+	apply(t, a, b, ops)
+
+	if got := cost(ops); got != len(a)+len(b) {
+		t.Errorf("%d edits, want %d", got, len(a)+len(b))
+	}
+}
+
+func TestUnified(t *testing.T) {
+	from := "a\nb\nc\nd\ne\nf\ng\nh\ni\nj\nk\nl\nm\n"
+	to := "a\nb\nc\nD\ne\nf\ng\nh\ni\nj\nk\nl\nm\nn"
+	want := `--- v1
++++ v2
+@@ -1,7 +1,7 @@
+ a
+ b
+ c
+-d
++D
+ e
+ f
+ g
+@@ -11,3 +11,4 @@
+ k
+ l
+ m
++n
+\ No newline at end of file
+`
+
+	if got := Unified("v1", "v2", from, to); got != want {
+		t.Errorf("Unified() =\n%s\nwant\n%s", got, want)
+	}
+
+	if got := Unified("v1", "v2", from, from); got != "" {
+		t.Errorf("Unified() of equal texts = %q", got)
+	}
+
+	if got, want := Unified("v1", "v2", "", "a\n"), "--- v1\n+++ v2\n@@ -0,0 +1 @@\n+a\n"; got != want {
+		t.Errorf("Unified() from empty = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,294 @@
+// Package envelope encrypts stored config data with a fresh data key per
+// record. Data keys are wrapped by a master key from a keyring, so rotating
+// the master key only needs the small wrapped keys to be rewritten.
+//
+// Sealed records look like envelope:v1:<master key id>:<wrapped key>:<ciphertext>.
+// The ciphertext is bound to the record it was written for through associated
+// data, so it cannot be moved to another record. Anything without an envelope
+// prefix is treated as plaintext, which keeps
+// records written before encryption was enabled readable.
+package envelope
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/osamikoyo/yoconf/keyring"
+)
+
+const (
+	Prefix = "envelope:v1:"
+
+	// plainPrefix escapes plaintext that would otherwise read as an envelope,
+	// for data written while encryption is disabled.
+	plainPrefix = "envelope:plain:"
+	marker      = "envelope:"
+)
+
+var (
+	ErrNoMasterKey = errors.New("no master key configured")
+	ErrMalformed   = errors.New("malformed envelope")
+)
+
+// Crypter seals and opens records. A nil Crypter leaves new data in plaintext.
+type Crypter struct {
+	keys keyring.KeyProvider
+}
+
+func NewCrypter(keys keyring.KeyProvider) *Crypter {
+	return &Crypter{
+		keys: keys,
+	}
+}
+
+func IsSealed(data string) bool {
+	return strings.HasPrefix(data, Prefix)
+}
+
+// Bind returns the associated data naming the record a ciphertext belongs to.
+func Bind(project, environment string, version int) []byte {
+	return []byte(strconv.Quote(project) + "/" + strconv.Quote(environment) + "/" + strconv.Itoa(version))
+}
+
+type sealed struct {
+	keyID      string
+	wrapped    []byte
+	ciphertext []byte
+}
+
+func parse(data string) (sealed, error) {
+	rest := strings.TrimPrefix(data, Prefix)
+
+	head, encoded, ok := cutLast(rest)
+	if !ok {
+		return sealed{}, ErrMalformed
+	}
+
+	keyID, wrappedEncoded, ok := cutLast(head)
+	if !ok || keyID == "" {
+		return sealed{}, ErrMalformed
+	}
+
+	wrapped, err := base64.StdEncoding.DecodeString(wrappedEncoded)
+	if err != nil {
+		return sealed{}, ErrMalformed
+	}
+
+	ciphertext, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return sealed{}, ErrMalformed
+	}
+
+	return sealed{keyID: keyID, wrapped: wrapped, ciphertext: ciphertext}, nil
+}
+
+func cutLast(s string) (string, string, bool) {
+	i := strings.LastIndex(s, ":")
+	if i < 0 {
+		return "", "", false
+	}
+
+	return s[:i], s[i+1:], true
+}
+
+func (s sealed) String() string {
+	return Prefix + s.keyID + ":" +
+		base64.StdEncoding.EncodeToString(s.wrapped) + ":" +
+		base64.StdEncoding.EncodeToString(s.ciphertext)
+}
+
+// KeyID reports the master key protecting data, or false for plaintext.
+func KeyID(data string) (string, bool) {
+	if !IsSealed(data) {
+		return "", false
+	}
+
+	record, err := parse(data)
+	if err != nil {
+		return "", false
+	}
+
+	return record.keyID, true
+}
+
+func seal(key, plaintext, aad []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(nonce, nonce, plaintext, aad), nil
+}
+
+func open(key, ciphertext, aad []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, ErrMalformed
+	}
+
+	nonce, ciphertext := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+
+	plaintext, err := aead.Open(nil, nonce, ciphertext, aad)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformed, err)
+	}
+
+	return plaintext, nil
+}
+
+func (c *Crypter) wrap(dataKey []byte) (string, []byte, error) {
+	id, master, err := c.keys.Current()
+	if err != nil {
+		return "", nil, err
+	}
+
+	wrapped, err := seal(master, dataKey, []byte(id))
+	if err != nil {
+		return "", nil, err
+	}
+
+	return id, wrapped, nil
+}
+
+func (c *Crypter) unwrap(record sealed) ([]byte, error) {
+	master, err := c.keys.Key(record.keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return open(master, record.wrapped, []byte(record.keyID))
+}
+
+func (c *Crypter) Enabled() bool {
+	return c != nil && c.keys != nil
+}
+
+// Encrypt seals data bound to aad under a new data key wrapped by the current
+// master key. Without a master key data stays in plaintext.
+func (c *Crypter) Encrypt(data string, aad []byte) (string, error) {
+	if !c.Enabled() {
+		if strings.HasPrefix(data, marker) {
+			return plainPrefix + data, nil
+		}
+
+		return data, nil
+	}
+
+	dataKey := make([]byte, keyring.KeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return "", err
+	}
+
+	ciphertext, err := seal(dataKey, []byte(data), aad)
+	if err != nil {
+		return "", err
+	}
+
+	id, wrapped, err := c.wrap(dataKey)
+	if err != nil {
+		return "", err
+	}
+
+	return sealed{keyID: id, wrapped: wrapped, ciphertext: ciphertext}.String(), nil
+}
+
+// Decrypt opens data sealed for the record named by aad.
+func (c *Crypter) Decrypt(data string, aad []byte) (string, error) {
+	if plain, ok := strings.CutPrefix(data, plainPrefix); ok {
+		return plain, nil
+	}
+
+	if !IsSealed(data) {
+		return data, nil
+	}
+
+	if !c.Enabled() {
+		return "", ErrNoMasterKey
+	}
+
+	record, err := parse(data)
+	if err != nil {
+		return "", err
+	}
+
+	dataKey, err := c.unwrap(record)
+	if err != nil {
+		return "", err
+	}
+
+	plaintext, err := open(dataKey, record.ciphertext, aad)
+	if err != nil {
+		return "", err
+	}
+
+	return string(plaintext), nil
+}
+
+// Rewrap moves data of the record named by aad under the current master key.
+// Plaintext is sealed and sealed data only has its data key rewrapped. It reports whether data changed.
+func (c *Crypter) Rewrap(data string, aad []byte) (string, bool, error) {
+	if !c.Enabled() {
+		return data, false, nil
+	}
+
+	if !IsSealed(data) {
+		plaintext, err := c.Decrypt(data, aad)
+		if err != nil {
+			return "", false, err
+		}
+
+		sealedData, err := c.Encrypt(plaintext, aad)
+
+		return sealedData, err == nil, err
+	}
+
+	record, err := parse(data)
+	if err != nil {
+		return "", false, err
+	}
+
+	current, _, err := c.keys.Current()
+	if err != nil {
+		return "", false, err
+	}
+
+	if record.keyID == current {
+		return data, false, nil
+	}
+
+	dataKey, err := c.unwrap(record)
+	if err != nil {
+		return "", false, err
+	}
+
+	if record.keyID, record.wrapped, err = c.wrap(dataKey); err != nil {
+		return "", false, err
+	}
+
+	return record.String(), true, nil
+}
@@ -0,0 +1,130 @@
+package envelope
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/osamikoyo/yoconf/keyring"
+)
+
+const (
+	key1 = "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="
+	key2 = "YWJjZGVmMDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODk="
+)
+
+func keys(t *testing.T, body string) keyring.KeyProvider {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "keys.yaml")
+	if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	provider, err := keyring.NewFileProvider(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return provider
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	c := NewCrypter(keys(t, "current: k1\nkeys:\n  k1: "+key1+"\n"))
+	aad := Bind("app", "prod", 3)
+
+	for _, data := range []string{"a: 1\n", "", Prefix + "k1:AAAA:AAAA", plainPrefix + "y"} {
+		sealed, err := c.Encrypt(data, aad)
+		if err != nil {
+			t.Fatalf("Encrypt(%q) = %v", data, err)
+		}
+
+		if !strings.HasPrefix(sealed, Prefix+"k1:") {
+			t.Errorf("Encrypt(%q) = %q, not sealed", data, sealed)
+		}
+
+		if got, err := c.Decrypt(sealed, aad); err != nil || got != data {
+			t.Errorf("Decrypt(Encrypt(%q)) = %q, %v", data, got, err)
+		}
+	}
+}
+
+func TestBind(t *testing.T) {
+	c := NewCrypter(keys(t, "current: k1\nkeys:\n  k1: "+key1+"\n"))
+
+	sealed, err := c.Encrypt("a: 1\n", Bind("app", "prod", 3))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, aad := range [][]byte{
+		Bind("app", "prod", 2),
+		Bind("app", "dev", 3),
+		Bind("other", "prod", 3),
+		Bind("app/prod", "", 3),
+		nil,
+	} {
+		if _, err := c.Decrypt(sealed, aad); !errors.Is(err, ErrMalformed) {
+			t.Errorf("Decrypt() for %q = %v, want %v", aad, err, ErrMalformed)
+		}
+	}
+}
+
+func TestDisabled(t *testing.T) {
+	c := NewCrypter(nil)
+
+	for _, data := range []string{"a: 1\n", Prefix + "k1:AAAA:AAAA"} {
+		stored, err := c.Encrypt(data, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got, err := c.Decrypt(stored, nil); err != nil || got != data {
+			t.Errorf("Decrypt(Encrypt(%q)) = %q, %v", data, got, err)
+		}
+	}
+
+	sealed, err := NewCrypter(keys(t, "current: k1\nkeys:\n  k1: "+key1+"\n")).Encrypt("a", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = c.Decrypt(sealed, nil); !errors.Is(err, ErrNoMasterKey) {
+		t.Errorf("Decrypt() without keys = %v, want %v", err, ErrNoMasterKey)
+	}
+}
+
+func TestRewrap(t *testing.T) {
+	old := NewCrypter(keys(t, "current: k1\nkeys:\n  k1: "+key1+"\n"))
+	rotated := NewCrypter(keys(t, "current: k2\nkeys:\n  k1: "+key1+"\n  k2: "+key2+"\n"))
+	aad := Bind("app", "prod", 1)
+
+	sealed, err := old.Encrypt("a: 1\n", aad)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rewrapped, changed, err := rotated.Rewrap(sealed, aad)
+	if err != nil || !changed {
+		t.Fatalf("Rewrap() = %v, %v", changed, err)
+	}
+
+	if id, _ := KeyID(rewrapped); id != "k2" {
+		t.Errorf("KeyID() after rewrap = %q, want k2", id)
+	}
+
+	if _, changed, _ = rotated.Rewrap(rewrapped, aad); changed {
+		t.Error("Rewrap() under the current key changed the record")
+	}
+
+	if got, err := rotated.Decrypt(rewrapped, aad); err != nil || got != "a: 1\n" {
+		t.Errorf("Decrypt() after rewrap = %q, %v", got, err)
+	}
+
+	plain, changed, err := rotated.Rewrap("b: 2\n", aad)
+	if err != nil || !changed || !IsSealed(plain) {
+		t.Errorf("Rewrap() of plaintext = %q, %v, %v", plain, changed, err)
+	}
+}
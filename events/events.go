@@ -0,0 +1,94 @@
+package events
+
+import (
+	"sync"
+	"time"
+
+	"github.com/osamikoyo/yoconf/logger"
+	"go.uber.org/zap"
+)
+
+const (
+	Published         = "published"
+	Activated         = "activated"
+	Deleted           = "deleted"
+	DependencyChanged = "dependency_changed"
+	RolloutChanged    = "rollout_changed"
+	TargetingChanged  = "targeting_changed"
+	Scheduled         = "scheduled"
+	ScheduleCancelled = "schedule_cancelled"
+	ScheduleFailed    = "schedule_failed"
+	OverrideReverted  = "override_reverted"
+	ProposalCreated   = "proposal_created"
+	ProposalApproved  = "proposal_approved"
+	ProposalRejected  = "proposal_rejected"
+)
+
+type Event struct {
+	Type        string    `json:"type"`
+	Project     string    `json:"project"`
+	Environment string    `json:"environment"`
+	Version     int       `json:"version,omitempty"`
+	Source      string    `json:"source,omitempty"`
+	Actor       string    `json:"actor,omitempty"`
+	Time        time.Time `json:"time"`
+}
+
+type Bus struct {
+	mu          sync.RWMutex
+	next        int
+	subscribers map[int]chan Event
+	logger      *logger.Logger
+}
+
+func NewBus(logger *logger.Logger) *Bus {
+	return &Bus{
+		subscribers: map[int]chan Event{},
+		logger:      logger,
+	}
+}
+
+// Publish fans an event out to every subscriber. Subscribers that are not
+// keeping up miss the event instead of blocking the publisher.
+func (b *Bus) Publish(event Event) {
+	if event.Time.IsZero() {
+		event.Time = time.Now().UTC()
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for id, ch := range b.subscribers {
+		select {
+		case ch <- event:
+		default:
+			b.logger.Warn("dropping event for slow subscriber",
+				zap.Int("subscriber", id),
+				zap.String("type", event.Type),
+				zap.String("project", event.Project))
+		}
+	}
+}
+
+func (b *Bus) Subscribe(buffer int) (<-chan Event, func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	id := b.next
+	b.next++
+
+	ch := make(chan Event, buffer)
+	b.subscribers[id] = ch
+
+	var once sync.Once
+
+	return ch, func() {
+		once.Do(func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+
+			delete(b.subscribers, id)
+			close(ch)
+		})
+	}
+}
@@ -0,0 +1,332 @@
+// Package flags evaluates feature flags defined in the data of flag projects.
+//
+// A flag project holds a document like
+//
+//	flags:
+//	  new-checkout:
+//	    type: boolean
+//	    variants: {on: true, off: false}
+//	    default: off
+//	    rules:
+//	      - name: beta
+//	        selector: tier=beta
+//	        variant: on
+//	    split:
+//	      - {variant: on, percent: 20}
+//	      - {variant: off, percent: 80}
+//
+// Rules are label selectors over the evaluation context and are tried in
+// order. Splits place the targeting key in a sticky percentage bucket.
+package flags
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"slices"
+
+	"github.com/osamikoyo/yoconf/selector"
+)
+
+const (
+	TypeBoolean = "boolean"
+	TypeString  = "string"
+	TypeNumber  = "number"
+	TypeJSON    = "json"
+
+	StateEnabled  = "ENABLED"
+	StateDisabled = "DISABLED"
+
+	ReasonStatic         = "STATIC"
+	ReasonDefault        = "DEFAULT"
+	ReasonTargetingMatch = "TARGETING_MATCH"
+	ReasonSplit          = "SPLIT"
+	ReasonDisabled       = "DISABLED"
+	ReasonError          = "ERROR"
+
+	ErrorFlagNotFound = "FLAG_NOT_FOUND"
+	ErrorTypeMismatch = "TYPE_MISMATCH"
+	ErrorParse        = "PARSE_ERROR"
+	ErrorGeneral      = "GENERAL"
+
+	// TargetingKey is the label under which the targeting key is visible
+	// to rule selectors.
+	TargetingKey = "targetingKey"
+)
+
+var ErrInvalidFlags = errors.New("invalid flags")
+
+type Split struct {
+	Variant string `json:"variant"`
+	Percent int    `json:"percent"`
+}
+
+type Rule struct {
+	Name     string  `json:"name"`
+	Selector string  `json:"selector"`
+	Variant  string  `json:"variant,omitempty"`
+	Split    []Split `json:"split,omitempty"`
+
+	selector selector.Selector
+}
+
+type Flag struct {
+	Type     string         `json:"type"`
+	State    string         `json:"state,omitempty"`
+	Variants map[string]any `json:"variants"`
+	Default  string         `json:"default"`
+	Rules    []Rule         `json:"rules,omitempty"`
+	Split    []Split        `json:"split,omitempty"`
+}
+
+// Set is the parsed flag document of a project.
+type Set struct {
+	Flags map[string]*Flag `json:"flags"`
+}
+
+// Context is what flags are evaluated against.
+type Context struct {
+	TargetingKey string            `json:"targeting_key,omitempty"`
+	Attributes   map[string]string `json:"attributes,omitempty"`
+}
+
+type Result struct {
+	Key          string `json:"key"`
+	Type         string `json:"type,omitempty"`
+	Value        any    `json:"value,omitempty"`
+	Variant      string `json:"variant,omitempty"`
+	Reason       string `json:"reason"`
+	ErrorCode    string `json:"error_code,omitempty"`
+	ErrorMessage string `json:"error_message,omitempty"`
+}
+
+// Parse builds a flag set from parsed config data and checks every flag.
+func Parse(doc any) (*Set, error) {
+	raw, err := json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFlags, err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+
+	var set Set
+	if err = decoder.Decode(&set); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFlags, err)
+	}
+
+	for key, flag := range set.Flags {
+		if flag == nil {
+			return nil, fmt.Errorf("%w: flag %q is empty", ErrInvalidFlags, key)
+		}
+
+		if err = flag.check(); err != nil {
+			return nil, fmt.Errorf("%w: flag %q: %v", ErrInvalidFlags, key, err)
+		}
+	}
+
+	return &set, nil
+}
+
+func (f *Flag) check() error {
+	switch f.State {
+	case "":
+		f.State = StateEnabled
+	case StateEnabled, StateDisabled:
+	default:
+		return fmt.Errorf("unknown state %q", f.State)
+	}
+
+	if len(f.Variants) == 0 {
+		return errors.New("no variants")
+	}
+
+	for name, value := range f.Variants {
+		if !typeOf(f.Type, value) {
+			return fmt.Errorf("variant %q is not a %s", name, f.Type)
+		}
+	}
+
+	if _, ok := f.Variants[f.Default]; !ok {
+		return fmt.Errorf("default variant %q is not defined", f.Default)
+	}
+
+	for i := range f.Rules {
+		rule := &f.Rules[i]
+
+		var err error
+		if rule.selector, err = selector.Parse(rule.Selector); err != nil {
+			return fmt.Errorf("rule %q: %v", rule.Name, err)
+		}
+
+		switch {
+		case rule.Variant != "" && len(rule.Split) > 0:
+			return fmt.Errorf("rule %q has both a variant and a split", rule.Name)
+		case rule.Variant != "":
+			if _, ok := f.Variants[rule.Variant]; !ok {
+				return fmt.Errorf("rule %q: variant %q is not defined", rule.Name, rule.Variant)
+			}
+		case len(rule.Split) > 0:
+			if err = f.checkSplit(rule.Split); err != nil {
+				return fmt.Errorf("rule %q: %v", rule.Name, err)
+			}
+		default:
+			return fmt.Errorf("rule %q has neither a variant nor a split", rule.Name)
+		}
+	}
+
+	if len(f.Split) > 0 {
+		return f.checkSplit(f.Split)
+	}
+
+	return nil
+}
+
+func (f *Flag) checkSplit(split []Split) error {
+	total := 0
+	for _, part := range split {
+		if _, ok := f.Variants[part.Variant]; !ok {
+			return fmt.Errorf("split variant %q is not defined", part.Variant)
+		}
+
+		if part.Percent < 0 {
+			return fmt.Errorf("split percent %d is negative", part.Percent)
+		}
+
+		total += part.Percent
+	}
+
+	if total != 100 {
+		return fmt.Errorf("split percents add up to %d, not 100", total)
+	}
+
+	return nil
+}
+
+func typeOf(typ string, value any) bool {
+	switch typ {
+	case TypeBoolean:
+		_, ok := value.(bool)
+		return ok
+	case TypeString:
+		_, ok := value.(string)
+		return ok
+	case TypeNumber:
+		_, ok := value.(float64)
+		return ok
+	case TypeJSON:
+		return true
+	default:
+		return false
+	}
+}
+
+// Keys returns the flag keys in order.
+func (s *Set) Keys() []string {
+	keys := make([]string, 0, len(s.Flags))
+	for key := range s.Flags {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	return keys
+}
+
+// Evaluate resolves one flag. Problems are reported in the result rather
+// than as an error, so bulk evaluation can go on.
+func (s *Set) Evaluate(key string, ctx Context) Result {
+	flag, ok := s.Flags[key]
+	if !ok {
+		return Result{
+			Key:          key,
+			Reason:       ReasonError,
+			ErrorCode:    ErrorFlagNotFound,
+			ErrorMessage: fmt.Sprintf("flag %q is not defined", key),
+		}
+	}
+
+	result := Result{Key: key, Type: flag.Type}
+
+	variant, reason := flag.resolve(key, ctx)
+	result.Variant, result.Value, result.Reason = variant, flag.Variants[variant], reason
+
+	return result
+}
+
+// EvaluateAll resolves the given flags, or every flag when keys is empty.
+func (s *Set) EvaluateAll(keys []string, ctx Context) []Result {
+	if len(keys) == 0 {
+		keys = s.Keys()
+	}
+
+	results := make([]Result, len(keys))
+	for i, key := range keys {
+		results[i] = s.Evaluate(key, ctx)
+	}
+
+	return results
+}
+
+func (f *Flag) resolve(key string, ctx Context) (string, string) {
+	if f.State == StateDisabled {
+		return f.Default, ReasonDisabled
+	}
+
+	labels := make(map[string]string, len(ctx.Attributes)+1)
+	for name, value := range ctx.Attributes {
+		labels[name] = value
+	}
+
+	if ctx.TargetingKey != "" {
+		labels[TargetingKey] = ctx.TargetingKey
+	}
+
+	for _, rule := range f.Rules {
+		if !rule.selector.Matches(labels) {
+			continue
+		}
+
+		if rule.Variant != "" {
+			return rule.Variant, ReasonTargetingMatch
+		}
+
+		if variant, ok := pick(rule.Split, key, ctx.TargetingKey); ok {
+			return variant, ReasonSplit
+		}
+	}
+
+	if variant, ok := pick(f.Split, key, ctx.TargetingKey); ok {
+		return variant, ReasonSplit
+	}
+
+	if len(f.Rules) == 0 && len(f.Split) == 0 {
+		return f.Default, ReasonStatic
+	}
+
+	return f.Default, ReasonDefault
+}
+
+// pick places targetingKey in a sticky bucket of split. Without a targeting
+// key there is nothing to keep sticky, so no variant is picked.
+func pick(split []Split, key, targetingKey string) (string, bool) {
+	if len(split) == 0 || targetingKey == "" {
+		return "", false
+	}
+
+	h := fnv.New32a()
+	h.Write([]byte(key + "/" + targetingKey))
+	bucket := int(h.Sum32() % 100)
+
+	for _, part := range split {
+		if bucket < part.Percent {
+			return part.Variant, true
+		}
+
+		bucket -= part.Percent
+	}
+
+	return "", false
+}
@@ -0,0 +1,154 @@
+package flags
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/osamikoyo/yoconf/format"
+)
+
+const doc = `
+flags:
+  checkout:
+    type: boolean
+    variants: {on: true, off: false}
+    default: off
+    rules:
+      - name: beta
+        selector: tier=beta
+        variant: on
+      - name: eu
+        selector: region=eu
+        split:
+          - {variant: on, percent: 50}
+          - {variant: off, percent: 50}
+  color:
+    type: string
+    variants: {red: red, blue: blue}
+    default: red
+  limit:
+    type: number
+    variants: {low: 10, high: 100}
+    default: low
+    split:
+      - {variant: low, percent: 25}
+      - {variant: high, percent: 75}
+  banner:
+    type: json
+    state: DISABLED
+    variants: {on: {text: hi}, off: {}}
+    default: off
+`
+
+func parse(t *testing.T, data string) (*Set, error) {
+	t.Helper()
+
+	value, err := format.Parse(format.YAML, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return Parse(value)
+}
+
+func TestEvaluate(t *testing.T) {
+	set, err := parse(t, doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		key     string
+		ctx     Context
+		variant string
+		reason  string
+	}{
+		{"checkout", Context{}, "off", ReasonDefault},
+		{"checkout", Context{Attributes: map[string]string{"tier": "beta"}}, "on", ReasonTargetingMatch},
+		{"checkout", Context{TargetingKey: "u1", Attributes: map[string]string{"tier": "beta", "region": "eu"}}, "on", ReasonTargetingMatch},
+		{"checkout", Context{Attributes: map[string]string{"region": "eu"}}, "off", ReasonDefault},
+		{"color", Context{TargetingKey: "u1"}, "red", ReasonStatic},
+		{"limit", Context{}, "low", ReasonDefault},
+		{"banner", Context{}, "off", ReasonDisabled},
+	}
+
+	for _, tt := range tests {
+		got := set.Evaluate(tt.key, tt.ctx)
+		if got.Variant != tt.variant || got.Reason != tt.reason || got.ErrorCode != "" {
+			t.Errorf("Evaluate(%q, %+v) = %+v, want %s/%s", tt.key, tt.ctx, got, tt.variant, tt.reason)
+		}
+	}
+
+	if got := set.Evaluate("nope", Context{}); got.ErrorCode != ErrorFlagNotFound || got.Reason != ReasonError {
+		t.Errorf("Evaluate(nope) = %+v", got)
+	}
+}
+
+func TestSplit(t *testing.T) {
+	set, err := parse(t, doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	counts := map[string]int{}
+
+	for i := 0; i < 2000; i++ {
+		ctx := Context{TargetingKey: fmt.Sprint("user-", i)}
+
+		got := set.Evaluate("limit", ctx)
+		if got.Reason != ReasonSplit {
+			t.Fatalf("Evaluate(limit) = %+v, want a split", got)
+		}
+
+		if again := set.Evaluate("limit", ctx); again.Variant != got.Variant {
+			t.Fatalf("%s flipped from %s to %s", ctx.TargetingKey, got.Variant, again.Variant)
+		}
+
+		counts[got.Variant]++
+	}
+
+	if share := float64(counts["high"]) / 2000; math.Abs(share-0.75) > 0.05 {
+		t.Errorf("high share = %.2f, want about 0.75", share)
+	}
+}
+
+func TestEvaluateAll(t *testing.T) {
+	set, err := parse(t, doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results := set.EvaluateAll(nil, Context{})
+	if len(results) != 4 || results[0].Key != "banner" || results[3].Key != "limit" {
+		t.Errorf("EvaluateAll() = %+v", results)
+	}
+
+	results = set.EvaluateAll([]string{"color", "nope"}, Context{})
+	if len(results) != 2 || results[0].Value != "red" || results[1].ErrorCode != ErrorFlagNotFound {
+		t.Errorf("EvaluateAll(color, nope) = %+v", results)
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	tests := map[string]string{
+		"unknown field":     "flags:\n  a: {type: boolean, variants: {on: true}, default: on, extra: 1}\n",
+		"no variants":       "flags:\n  a: {type: boolean, default: on}\n",
+		"wrong type":        "flags:\n  a: {type: boolean, variants: {on: yes please}, default: on}\n",
+		"unknown type":      "flags:\n  a: {type: date, variants: {on: x}, default: on}\n",
+		"missing default":   "flags:\n  a: {type: boolean, variants: {on: true}, default: off}\n",
+		"unknown state":     "flags:\n  a: {type: boolean, state: PAUSED, variants: {on: true}, default: on}\n",
+		"bad selector":      "flags:\n  a: {type: boolean, variants: {on: true}, default: on, rules: [{name: r, selector: 'x in (', variant: on}]}\n",
+		"rule without goal": "flags:\n  a: {type: boolean, variants: {on: true}, default: on, rules: [{name: r, selector: x}]}\n",
+		"split over 100":    "flags:\n  a: {type: boolean, variants: {on: true}, default: on, split: [{variant: on, percent: 101}]}\n",
+		"split variant":     "flags:\n  a: {type: boolean, variants: {on: true}, default: on, split: [{variant: off, percent: 100}]}\n",
+		"empty flag":        "flags:\n  a:\n",
+	}
+
+	for name, data := range tests {
+		if _, err := parse(t, data); !errors.Is(err, ErrInvalidFlags) {
+			t.Errorf("%s: Parse() error = %v, want %v", name, err, ErrInvalidFlags)
+		}
+	}
+}
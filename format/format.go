@@ -0,0 +1,432 @@
+package format
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/joho/godotenv"
+	"github.com/magiconair/properties"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	YAML       = "yaml"
+	JSON       = "json"
+	TOML       = "toml"
+	Env        = "env"
+	Properties = "properties"
+)
+
+var (
+	ErrUnknownFormat = errors.New("unknown format")
+	ErrInvalidData   = errors.New("invalid data")
+	ErrUnsupported   = errors.New("unsupported conversion")
+)
+
+var mediaTypes = map[string]string{
+	YAML:       "application/yaml",
+	JSON:       "application/json",
+	TOML:       "application/toml",
+	Env:        "text/x-dotenv",
+	Properties: "text/x-java-properties",
+}
+
+var aliases = map[string]string{
+	"yml":                  YAML,
+	"application/x-yaml":   YAML,
+	"text/yaml":            YAML,
+	"text/x-yaml":          YAML,
+	"dotenv":               Env,
+	".env":                 Env,
+	"application/x-dotenv": Env,
+	"props":                Properties,
+	"text/x-properties":    Properties,
+}
+
+func Known() []string {
+	return []string{YAML, JSON, TOML, Env, Properties}
+}
+
+// Normalize maps a format name, file extension or media type to a format,
+// returning an empty string for an empty input.
+func Normalize(name string) (string, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return "", nil
+	}
+
+	if _, ok := mediaTypes[name]; ok {
+		return name, nil
+	}
+
+	if format, ok := aliases[name]; ok {
+		return format, nil
+	}
+
+	for format, mediaType := range mediaTypes {
+		if mediaType == name {
+			return format, nil
+		}
+	}
+
+	return "", fmt.Errorf("%w: %q", ErrUnknownFormat, name)
+}
+
+func MediaType(format string) string {
+	if mediaType, ok := mediaTypes[format]; ok {
+		return mediaType
+	}
+
+	return "text/plain"
+}
+
+// FromAccept picks the first config format listed in an Accept header.
+// Wildcards and unknown media types are skipped.
+func FromAccept(accept string) string {
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+
+		if format, err := Normalize(mediaType); err == nil && format != "" {
+			return format
+		}
+	}
+
+	return ""
+}
+
+func Parse(format string, data string) (any, error) {
+	var (
+		value any
+		err   error
+	)
+
+	switch format {
+	case YAML:
+		err = yaml.Unmarshal([]byte(data), &value)
+	case JSON:
+		decoder := json.NewDecoder(strings.NewReader(data))
+		decoder.UseNumber()
+		err = decoder.Decode(&value)
+		if err == nil && decoder.More() {
+			err = errors.New("unexpected data after top-level value")
+		}
+	case TOML:
+		doc := map[string]any{}
+		_, err = toml.Decode(data, &doc)
+		value = doc
+	case Env:
+		var env map[string]string
+		env, err = godotenv.Unmarshal(data)
+		if err == nil {
+			doc := make(map[string]any, len(env))
+			for key, val := range env {
+				doc[key] = val
+			}
+			value = doc
+		}
+	case Properties:
+		var props *properties.Properties
+		props, err = properties.LoadString(data)
+		if err == nil {
+			value = unflatten(props.Map())
+		}
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownFormat, format)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %v", ErrInvalidData, format, err)
+	}
+
+	return normalize(value), nil
+}
+
+func Validate(format string, data string) error {
+	_, err := Parse(format, data)
+
+	return err
+}
+
+func Encode(format string, value any) (string, error) {
+	switch format {
+	case YAML:
+		buf := bytes.Buffer{}
+		encoder := yaml.NewEncoder(&buf)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(value); err != nil {
+			return "", err
+		}
+
+		return buf.String(), nil
+	case JSON:
+		data, err := json.MarshalIndent(value, "", "  ")
+		if err != nil {
+			return "", err
+		}
+
+		return string(data) + "\n", nil
+	case TOML:
+		doc, ok := value.(map[string]any)
+		if !ok {
+			return "", fmt.Errorf("%w: toml needs a table at the root", ErrUnsupported)
+		}
+
+		buf := bytes.Buffer{}
+		if err := toml.NewEncoder(&buf).Encode(doc); err != nil {
+			return "", err
+		}
+
+		return buf.String(), nil
+	case Env:
+		flat := map[string]string{}
+		if err := flatten("", "_", value, flat); err != nil {
+			return "", err
+		}
+
+		env := make(map[string]string, len(flat))
+		for key, val := range flat {
+			env[envKey(key)] = val
+		}
+
+		data, err := godotenv.Marshal(env)
+		if err != nil {
+			return "", err
+		}
+
+		return data + "\n", nil
+	case Properties:
+		flat := map[string]string{}
+		if err := flatten("", ".", value, flat); err != nil {
+			return "", err
+		}
+
+		keys := make([]string, 0, len(flat))
+		for key := range flat {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		props := properties.NewProperties()
+		for _, key := range keys {
+			if _, _, err := props.Set(key, flat[key]); err != nil {
+				return "", err
+			}
+		}
+
+		buf := bytes.Buffer{}
+		if _, err := props.Write(&buf, properties.UTF8); err != nil {
+			return "", err
+		}
+
+		return buf.String(), nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrUnknownFormat, format)
+	}
+}
+
+// Convert re-encodes data into another format. Data without a declared
+// format is read as YAML.
+func Convert(data, from, to string) (string, error) {
+	if from == "" {
+		from = YAML
+	}
+
+	if from == to {
+		return data, nil
+	}
+
+	value, err := Parse(from, data)
+	if err != nil {
+		return "", err
+	}
+
+	return Encode(to, value)
+}
+
+func normalize(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, val := range v {
+			v[key] = normalize(val)
+		}
+
+		return v
+	case map[any]any:
+		doc := make(map[string]any, len(v))
+		for key, val := range v {
+			doc[fmt.Sprint(key)] = normalize(val)
+		}
+
+		return doc
+	case []any:
+		for i, val := range v {
+			v[i] = normalize(val)
+		}
+
+		return v
+	case []map[string]any:
+		list := make([]any, len(v))
+		for i, val := range v {
+			list[i] = normalize(val)
+		}
+
+		return list
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return n
+		}
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+
+		return v.String()
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+	default:
+		return v
+	}
+}
+
+func scalar(value any) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case int:
+		return strconv.Itoa(v), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case uint64:
+		return strconv.FormatUint(v, 10), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	default:
+		return "", fmt.Errorf("%w: unexpected value of type %T", ErrUnsupported, value)
+	}
+}
+
+func flatten(prefix, sep string, value any, out map[string]string) error {
+	join := func(key string) string {
+		if prefix == "" {
+			return key
+		}
+
+		return prefix + sep + key
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		for key, val := range v {
+			if err := flatten(join(key), sep, val, out); err != nil {
+				return err
+			}
+		}
+	case []any:
+		for i, val := range v {
+			key := fmt.Sprintf("%s[%d]", prefix, i)
+			if sep != "." {
+				key = join(strconv.Itoa(i))
+			}
+
+			if err := flatten(key, sep, val, out); err != nil {
+				return err
+			}
+		}
+	default:
+		if prefix == "" {
+			return fmt.Errorf("%w: scalar root cannot be flattened", ErrUnsupported)
+		}
+
+		str, err := scalar(v)
+		if err != nil {
+			return err
+		}
+
+		out[prefix] = str
+	}
+
+	return nil
+}
+
+// Flatten turns a document into dotted property keys, with list items as
+// key[0], the way Spring and Java properties name them.
+func Flatten(value any) (map[string]string, error) {
+	flat := map[string]string{}
+	if err := flatten("", ".", value, flat); err != nil {
+		return nil, err
+	}
+
+	return flat, nil
+}
+
+func envKey(key string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_':
+			return r
+		default:
+			return '_'
+		}
+	}, key)
+}
+
+// unflatten turns dotted property keys into nested maps. Keys that clash with
+// an existing scalar are kept flat.
+func unflatten(flat map[string]string) map[string]any {
+	keys := make([]string, 0, len(flat))
+	for key := range flat {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	doc := map[string]any{}
+	for _, key := range keys {
+		node := doc
+		parts := strings.Split(key, ".")
+		ok := true
+
+		for _, part := range parts[:len(parts)-1] {
+			next, exists := node[part]
+			if !exists {
+				child := map[string]any{}
+				node[part] = child
+				node = child
+
+				continue
+			}
+
+			child, isMap := next.(map[string]any)
+			if !isMap {
+				ok = false
+				break
+			}
+			node = child
+		}
+
+		last := parts[len(parts)-1]
+		if _, exists := node[last]; !ok || exists {
+			doc[key] = flat[key]
+			continue
+		}
+
+		node[last] = flat[key]
+	}
+
+	return doc
+}
@@ -0,0 +1,185 @@
+package format
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := map[string]string{
+		"":                       "",
+		"YAML":                   YAML,
+		"yml":                    YAML,
+		"application/x-yaml":     YAML,
+		"application/json":       JSON,
+		" toml ":                 TOML,
+		".env":                   Env,
+		"text/x-java-properties": Properties,
+		"props":                  Properties,
+	}
+
+	for name, want := range tests {
+		if got, err := Normalize(name); err != nil || got != want {
+			t.Errorf("Normalize(%q) = %q, %v, want %q", name, got, err, want)
+		}
+	}
+
+	if _, err := Normalize("xml"); !errors.Is(err, ErrUnknownFormat) {
+		t.Errorf("Normalize(xml) error = %v, want %v", err, ErrUnknownFormat)
+	}
+}
+
+func TestFromAccept(t *testing.T) {
+	tests := map[string]string{
+		"":                                      "",
+		"*/*":                                   "",
+		"application/json":                      JSON,
+		"text/html, application/toml;q=0.9":     TOML,
+		"application/yaml; charset=utf-8, */*":  YAML,
+		"text/x-java-properties, text/x-dotenv": Properties,
+	}
+
+	for accept, want := range tests {
+		if got := FromAccept(accept); got != want {
+			t.Errorf("FromAccept(%q) = %q, want %q", accept, got, want)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	want := map[string]any{
+		"db": map[string]any{"host": "pg", "port": int64(5432)},
+	}
+
+	tests := []struct {
+		format string
+		data   string
+		want   any
+	}{
+		{YAML, "db:\n  host: pg\n  port: 5432\n", map[string]any{"db": map[string]any{"host": "pg", "port": 5432}}},
+		{JSON, `{"db": {"host": "pg", "port": 5432}}`, want},
+		{TOML, "[db]\nhost = \"pg\"\nport = 5432\n", want},
+		{Env, "DB_HOST=pg\nDB_PORT=5432\n", map[string]any{"DB_HOST": "pg", "DB_PORT": "5432"}},
+		{Properties, "db.host = pg\ndb.port = 5432\n", map[string]any{"db": map[string]any{"host": "pg", "port": "5432"}}},
+		{JSON, `{"ratio": 0.5}`, map[string]any{"ratio": 0.5}},
+		{Properties, "a = 1\na.b = 2\n", map[string]any{"a": "1", "a.b": "2"}},
+	}
+
+	for _, tt := range tests {
+		got, err := Parse(tt.format, tt.data)
+		if err != nil {
+			t.Errorf("Parse(%s, %q) error = %v", tt.format, tt.data, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Parse(%s, %q) = %#v, want %#v", tt.format, tt.data, got, tt.want)
+		}
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	tests := []struct {
+		format string
+		data   string
+		err    error
+	}{
+		{YAML, "a: [1, 2\n", ErrInvalidData},
+		{JSON, `{"a": 1} {"b": 2}`, ErrInvalidData},
+		{TOML, "a = \n", ErrInvalidData},
+		{"xml", "<a/>", ErrUnknownFormat},
+	}
+
+	for _, tt := range tests {
+		if _, err := Parse(tt.format, tt.data); !errors.Is(err, tt.err) {
+			t.Errorf("Parse(%s, %q) error = %v, want %v", tt.format, tt.data, err, tt.err)
+		}
+	}
+}
+
+func TestEncode(t *testing.T) {
+	value := map[string]any{
+		"db":    map[string]any{"host": "pg", "port": int64(5432)},
+		"hosts": []any{"a", "b"},
+	}
+
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{YAML, "db:\n  host: pg\n  port: 5432\nhosts:\n  - a\n  - b\n"},
+		{JSON, "{\n  \"db\": {\n    \"host\": \"pg\",\n    \"port\": 5432\n  },\n  \"hosts\": [\n    \"a\",\n    \"b\"\n  ]\n}\n"},
+		{Env, "DB_HOST=\"pg\"\nDB_PORT=5432\nHOSTS_0=\"a\"\nHOSTS_1=\"b\"\n"},
+		{Properties, "db.host = pg\ndb.port = 5432\nhosts[0] = a\nhosts[1] = b\n"},
+	}
+
+	for _, tt := range tests {
+		got, err := Encode(tt.format, value)
+		if err != nil {
+			t.Errorf("Encode(%s) error = %v", tt.format, err)
+			continue
+		}
+
+		if got != tt.want {
+			t.Errorf("Encode(%s) =\n%s\nwant\n%s", tt.format, got, tt.want)
+		}
+	}
+
+	if _, err := Encode(TOML, []any{1}); !errors.Is(err, ErrUnsupported) {
+		t.Errorf("Encode(toml, list) error = %v, want %v", err, ErrUnsupported)
+	}
+
+	if _, err := Encode(Env, "scalar"); !errors.Is(err, ErrUnsupported) {
+		t.Errorf("Encode(env, scalar) error = %v, want %v", err, ErrUnsupported)
+	}
+}
+
+func TestConvert(t *testing.T) {
+	data := "db:\n  host: pg\n  port: 5432\n"
+
+	for _, to := range Known() {
+		converted, err := Convert(data, "", to)
+		if err != nil {
+			t.Errorf("Convert(yaml, %s) error = %v", to, err)
+			continue
+		}
+
+		back, err := Convert(converted, to, YAML)
+		if err != nil {
+			t.Errorf("Convert(%s, yaml) error = %v", to, err)
+			continue
+		}
+
+		// Only these keep types and nesting on the way back.
+		if to == YAML || to == JSON || to == TOML {
+			if back != data {
+				t.Errorf("yaml -> %s -> yaml = %q, want %q", to, back, data)
+			}
+		}
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	got, err := Flatten(map[string]any{
+		"server": map[string]any{"port": 8080},
+		"hosts":  []any{"a", map[string]any{"name": "b"}},
+		"debug":  true,
+		"empty":  nil,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{
+		"server.port":   "8080",
+		"hosts[0]":      "a",
+		"hosts[1].name": "b",
+		"debug":         "true",
+		"empty":         "",
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Flatten() = %v, want %v", got, want)
+	}
+}
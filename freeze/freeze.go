@@ -0,0 +1,106 @@
+// Package freeze evaluates recurring change-freeze windows.
+package freeze
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/osamikoyo/yoconf/models"
+)
+
+const clock = "15:04"
+
+var ErrInvalidWindow = errors.New("invalid freeze window")
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// Check validates a window and normalizes its day names.
+func Check(window *models.FreezeWindow) error {
+	if window.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidWindow)
+	}
+
+	if window.Duration <= 0 {
+		return fmt.Errorf("%w: duration must be positive", ErrInvalidWindow)
+	}
+
+	if _, err := time.Parse(clock, window.Start); err != nil {
+		return fmt.Errorf("%w: start %q is not HH:MM", ErrInvalidWindow, window.Start)
+	}
+
+	if _, err := time.LoadLocation(window.Timezone); err != nil {
+		return fmt.Errorf("%w: timezone %q: %v", ErrInvalidWindow, window.Timezone, err)
+	}
+
+	for i, day := range window.Days {
+		day = strings.ToLower(day)
+		if len(day) > 3 {
+			day = day[:3]
+		}
+
+		if _, ok := weekdays[day]; !ok {
+			return fmt.Errorf("%w: unknown day %q", ErrInvalidWindow, window.Days[i])
+		}
+
+		window.Days[i] = day
+	}
+
+	return nil
+}
+
+// Active reports whether t falls into an occurrence of window and, if so,
+// when that occurrence ends. Windows that fail Check are never active.
+func Active(window *models.FreezeWindow, t time.Time) (time.Time, bool) {
+	start, err := time.Parse(clock, window.Start)
+	if err != nil || window.Duration <= 0 {
+		return time.Time{}, false
+	}
+
+	location, err := time.LoadLocation(window.Timezone)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	local := t.In(location)
+
+	// Occurrences that started up to the window length ago may still be open.
+	for back := 0; back <= int(window.Duration/(24*time.Hour))+1; back++ {
+		day := local.AddDate(0, 0, -back)
+		if !occursOn(window, day.Weekday()) {
+			continue
+		}
+
+		begin := time.Date(day.Year(), day.Month(), day.Day(), start.Hour(), start.Minute(), 0, 0, location)
+		end := begin.Add(window.Duration)
+
+		if !t.Before(begin) && t.Before(end) {
+			return end, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+func occursOn(window *models.FreezeWindow, weekday time.Weekday) bool {
+	if len(window.Days) == 0 {
+		return true
+	}
+
+	for _, day := range window.Days {
+		if weekdays[strings.ToLower(day)] == weekday {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,305 @@
+package grpcserver
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"slices"
+	"sort"
+	"sync"
+
+	"github.com/osamikoyo/yoconf/auth"
+	"github.com/osamikoyo/yoconf/core"
+	"github.com/osamikoyo/yoconf/models"
+	"github.com/osamikoyo/yoconf/pb/etcd"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// EtcdProgressWatchID is the watch ID of a response to a progress request,
+// as etcd sends it.
+const EtcdProgressWatchID = -1
+
+// EtcdServer serves the read side of etcd's v3 KV and Watch services, so
+// clientv3 loaders can read yoconf. Keys are described on core.RangeRequest
+// and revisions come from the global change sequence.
+type EtcdServer struct {
+	etcd.UnimplementedKVServer
+	etcd.UnimplementedWatchServer
+	core *core.Core
+}
+
+func NewEtcdServer(core *core.Core) *EtcdServer {
+	return &EtcdServer{
+		core: core,
+	}
+}
+
+// etcdError maps errors to the status codes and messages clientv3 knows.
+func etcdError(err error) error {
+	switch {
+	case errors.Is(err, core.ErrFutureRevision), errors.Is(err, core.ErrCompacted):
+		return status.Error(codes.OutOfRange, "etcdserver: "+err.Error())
+	case errors.Is(err, core.ErrCrossProject):
+		return status.Error(codes.Unimplemented, "etcdserver: "+err.Error())
+	case errors.Is(err, core.ErrNilInput):
+		return status.Error(codes.InvalidArgument, "etcdserver: key is not provided")
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func etcdKeyValue(kv models.KeyValue) *etcd.KeyValue {
+	return &etcd.KeyValue{
+		Key:            []byte(kv.Key),
+		Value:          kv.Value,
+		CreateRevision: kv.CreateRevision,
+		ModRevision:    kv.ModRevision,
+		Version:        int64(kv.Version),
+	}
+}
+
+// rangeFilter applies the revision filters of a range request.
+func rangeFilter(req *etcd.RangeRequest, kv models.KeyValue) bool {
+	switch {
+	case req.MinModRevision > 0 && kv.ModRevision < req.MinModRevision,
+		req.MaxModRevision > 0 && kv.ModRevision > req.MaxModRevision,
+		req.MinCreateRevision > 0 && kv.CreateRevision < req.MinCreateRevision,
+		req.MaxCreateRevision > 0 && kv.CreateRevision > req.MaxCreateRevision:
+		return false
+	default:
+		return true
+	}
+}
+
+// rangeLess orders keys by the sort target of a range request.
+func rangeLess(target etcd.RangeRequest_SortTarget, a, b models.KeyValue) bool {
+	switch target {
+	case etcd.RangeRequest_VERSION:
+		return a.Version < b.Version
+	case etcd.RangeRequest_CREATE:
+		return a.CreateRevision < b.CreateRevision
+	case etcd.RangeRequest_MOD:
+		return a.ModRevision < b.ModRevision
+	case etcd.RangeRequest_VALUE:
+		return bytes.Compare(a.Value, b.Value) < 0
+	default:
+		return a.Key < b.Key
+	}
+}
+
+func (s *EtcdServer) Range(ctx context.Context, req *etcd.RangeRequest) (*etcd.RangeResponse, error) {
+	kvs, revision, err := s.core.Range(core.RangeRequest{
+		Key:       string(req.Key),
+		RangeEnd:  string(req.RangeEnd),
+		Revision:  req.Revision,
+		Principal: auth.FromContext(ctx),
+	})
+	if err != nil {
+		return nil, etcdError(err)
+	}
+
+	kvs = slices.DeleteFunc(kvs, func(kv models.KeyValue) bool {
+		return !rangeFilter(req, kv)
+	})
+
+	sort.SliceStable(kvs, func(i, j int) bool {
+		if req.SortOrder == etcd.RangeRequest_DESCEND {
+			return rangeLess(req.SortTarget, kvs[j], kvs[i])
+		}
+
+		return rangeLess(req.SortTarget, kvs[i], kvs[j])
+	})
+
+	resp := &etcd.RangeResponse{
+		Header: &etcd.ResponseHeader{Revision: revision},
+		Count:  int64(len(kvs)),
+	}
+
+	if req.CountOnly {
+		return resp, nil
+	}
+
+	if req.Limit > 0 && int64(len(kvs)) > req.Limit {
+		kvs, resp.More = kvs[:req.Limit], true
+	}
+
+	for _, kv := range kvs {
+		if req.KeysOnly {
+			kv.Value = nil
+		}
+
+		resp.Kvs = append(resp.Kvs, etcdKeyValue(kv))
+	}
+
+	return resp, nil
+}
+
+// etcdEvents turns key events into watch events, leaving out those the watch
+// filters and previous values it did not ask for.
+func etcdEvents(req *etcd.WatchCreateRequest, events []models.KeyEvent) []*etcd.Event {
+	result := []*etcd.Event{}
+
+	for _, event := range events {
+		kind := etcd.Event_PUT
+		filter := etcd.WatchCreateRequest_NOPUT
+		if event.Deleted {
+			kind, filter = etcd.Event_DELETE, etcd.WatchCreateRequest_NODELETE
+		}
+
+		if slices.Contains(req.Filters, filter) {
+			continue
+		}
+
+		converted := &etcd.Event{
+			Type: kind,
+			Kv:   etcdKeyValue(event.KV),
+		}
+
+		if req.PrevKv && event.Prev != nil {
+			converted.PrevKv = etcdKeyValue(*event.Prev)
+		}
+
+		result = append(result, converted)
+	}
+
+	return result
+}
+
+// Watch runs every watch created on the stream until it is cancelled or the
+// stream ends. A watch without a start revision begins after the revision
+// current at its creation.
+func (s *EtcdServer) Watch(stream etcd.Watch_WatchServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	principal := auth.FromContext(stream.Context())
+
+	var mu sync.Mutex
+	send := func(resp *etcd.WatchResponse) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return stream.Send(resp)
+	}
+
+	watches := map[int64]context.CancelFunc{}
+	next := int64(0)
+
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		current, err := s.core.CurrentRevision()
+		if err != nil {
+			return etcdError(err)
+		}
+
+		header := &etcd.ResponseHeader{Revision: current}
+
+		switch request := req.RequestUnion.(type) {
+		case *etcd.WatchRequest_CreateRequest:
+			create := request.CreateRequest
+
+			id := create.WatchId
+			if id == 0 {
+				id = next
+			}
+			next = max(next, id+1)
+
+			if _, taken := watches[id]; taken {
+				if err = send(&etcd.WatchResponse{
+					Header:       header,
+					WatchId:      id,
+					Created:      true,
+					Canceled:     true,
+					CancelReason: "etcdserver: duplicate watch ID",
+				}); err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			start := create.StartRevision
+			if start <= 0 {
+				start = current + 1
+			}
+
+			if err = send(&etcd.WatchResponse{Header: header, WatchId: id, Created: true}); err != nil {
+				return err
+			}
+
+			watchCtx, stop := context.WithCancel(ctx)
+			watches[id] = stop
+
+			go func() {
+				err := s.core.Watch(watchCtx, core.WatchRequest{
+					Key:           string(create.Key),
+					RangeEnd:      string(create.RangeEnd),
+					StartRevision: start,
+					Principal:     principal,
+				}, func(revision int64, events []models.KeyEvent) error {
+					converted := etcdEvents(create, events)
+					if len(converted) == 0 {
+						return nil
+					}
+
+					return send(&etcd.WatchResponse{
+						Header:  &etcd.ResponseHeader{Revision: revision},
+						WatchId: id,
+						Events:  converted,
+					})
+				})
+				if err == nil || watchCtx.Err() != nil {
+					return
+				}
+
+				// clientv3 hands a compacted watch to its caller along
+				// with the revision to start over from.
+				var compacted *core.CompactedError
+				if errors.As(err, &compacted) {
+					_ = send(&etcd.WatchResponse{
+						Header:          header,
+						WatchId:         id,
+						Canceled:        true,
+						CompactRevision: compacted.Revision,
+					})
+
+					return
+				}
+
+				_ = send(&etcd.WatchResponse{
+					Header:       header,
+					WatchId:      id,
+					Canceled:     true,
+					CancelReason: err.Error(),
+				})
+			}()
+		case *etcd.WatchRequest_CancelRequest:
+			id := request.CancelRequest.WatchId
+
+			stop, ok := watches[id]
+			if !ok {
+				continue
+			}
+
+			stop()
+			delete(watches, id)
+
+			if err = send(&etcd.WatchResponse{Header: header, WatchId: id, Canceled: true}); err != nil {
+				return err
+			}
+		case *etcd.WatchRequest_ProgressRequest:
+			if err = send(&etcd.WatchResponse{Header: header, WatchId: EtcdProgressWatchID}); err != nil {
+				return err
+			}
+		}
+	}
+}
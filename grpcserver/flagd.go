@@ -0,0 +1,369 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/osamikoyo/yoconf/core"
+	"github.com/osamikoyo/yoconf/events"
+	"github.com/osamikoyo/yoconf/flags"
+	"github.com/osamikoyo/yoconf/format"
+	"github.com/osamikoyo/yoconf/models"
+	"github.com/osamikoyo/yoconf/pb/flagd"
+	"github.com/osamikoyo/yoconf/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	// FlagdSelectorHeader picks the flag project of a flagd request as
+	// "project" or "project/environment".
+	FlagdSelectorHeader = "flagd-selector"
+
+	FlagdProviderReady       = "provider_ready"
+	FlagdConfigurationChange = "configuration_change"
+)
+
+// FlagdServer serves the flags of yoconf flag projects over the flagd
+// evaluation protocol, so OpenFeature flagd providers can use yoconf.
+type FlagdServer struct {
+	flagd.UnimplementedServiceServer
+	core *core.Core
+	bus  *events.Bus
+
+	// project is used when a request has no selector.
+	project string
+}
+
+func NewFlagdServer(core *core.Core, bus *events.Bus, project string) *FlagdServer {
+	return &FlagdServer{
+		core:    core,
+		bus:     bus,
+		project: project,
+	}
+}
+
+func (s *FlagdServer) source(ctx context.Context) (string, string, error) {
+	selector := s.project
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(FlagdSelectorHeader); len(values) > 0 && values[0] != "" {
+			selector = values[0]
+		}
+	}
+
+	if selector == "" {
+		return "", "", status.Errorf(codes.InvalidArgument, "no flag project selected, set the %s header", FlagdSelectorHeader)
+	}
+
+	project, environment, _ := strings.Cut(selector, "/")
+
+	return project, models.EnvironmentOrDefault(environment), nil
+}
+
+// evaluationContext turns a flagd context into a targeting key and string
+// attributes. Nested objects become dotted attribute names.
+func evaluationContext(ctx *structpb.Struct) flags.Context {
+	attributes := map[string]string{}
+	for key, value := range ctx.GetFields() {
+		flatten(key, value.AsInterface(), attributes)
+	}
+
+	targetingKey := attributes[flags.TargetingKey]
+	delete(attributes, flags.TargetingKey)
+
+	return flags.Context{
+		TargetingKey: targetingKey,
+		Attributes:   attributes,
+	}
+}
+
+func flatten(name string, value any, out map[string]string) {
+	switch v := value.(type) {
+	case nil:
+	case map[string]any:
+		for key, val := range v {
+			flatten(name+"."+key, val, out)
+		}
+	case float64:
+		out[name] = strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		out[name] = fmt.Sprint(v)
+	}
+}
+
+// flagdError maps errors to the status codes flagd providers turn into
+// OpenFeature error codes.
+func flagdError(err error) error {
+	switch {
+	case errors.Is(err, core.ErrNotFlagProject),
+		errors.Is(err, storage.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, flags.ErrInvalidFlags),
+		errors.Is(err, format.ErrInvalidData):
+		return status.Error(codes.DataLoss, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func resultError(result flags.Result) error {
+	code := codes.Unknown
+	switch result.ErrorCode {
+	case flags.ErrorFlagNotFound:
+		code = codes.NotFound
+	case flags.ErrorTypeMismatch:
+		code = codes.InvalidArgument
+	case flags.ErrorParse:
+		code = codes.DataLoss
+	}
+
+	return status.Error(code, result.ErrorMessage)
+}
+
+func typeMismatch(result *flags.Result, want string) error {
+	return status.Errorf(codes.InvalidArgument, "flag %q is a %s flag, not %s", result.Key, result.Type, want)
+}
+
+func (s *FlagdServer) evaluate(ctx context.Context, keys []string, evaluation *structpb.Struct) ([]flags.Result, error) {
+	project, environment, err := s.source(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := s.core.EvaluateFlags(core.FlagRequest{
+		Project:     project,
+		Environment: environment,
+		Keys:        keys,
+		Context:     evaluationContext(evaluation),
+	})
+	if err != nil {
+		return nil, flagdError(err)
+	}
+
+	return results, nil
+}
+
+func (s *FlagdServer) resolve(ctx context.Context, key string, evaluation *structpb.Struct) (*flags.Result, error) {
+	results, err := s.evaluate(ctx, []string{key}, evaluation)
+	if err != nil {
+		return nil, err
+	}
+
+	if results[0].ErrorCode != "" {
+		return nil, resultError(results[0])
+	}
+
+	return &results[0], nil
+}
+
+func (s *FlagdServer) ResolveBoolean(ctx context.Context, req *flagd.ResolveBooleanRequest) (*flagd.ResolveBooleanResponse, error) {
+	result, err := s.resolve(ctx, req.FlagKey, req.Context)
+	if err != nil {
+		return nil, err
+	}
+
+	value, ok := result.Value.(bool)
+	if !ok {
+		return nil, typeMismatch(result, flags.TypeBoolean)
+	}
+
+	return &flagd.ResolveBooleanResponse{
+		Value:   value,
+		Reason:  result.Reason,
+		Variant: result.Variant,
+	}, nil
+}
+
+func (s *FlagdServer) ResolveString(ctx context.Context, req *flagd.ResolveStringRequest) (*flagd.ResolveStringResponse, error) {
+	result, err := s.resolve(ctx, req.FlagKey, req.Context)
+	if err != nil {
+		return nil, err
+	}
+
+	value, ok := result.Value.(string)
+	if !ok {
+		return nil, typeMismatch(result, flags.TypeString)
+	}
+
+	return &flagd.ResolveStringResponse{
+		Value:   value,
+		Reason:  result.Reason,
+		Variant: result.Variant,
+	}, nil
+}
+
+func (s *FlagdServer) ResolveFloat(ctx context.Context, req *flagd.ResolveFloatRequest) (*flagd.ResolveFloatResponse, error) {
+	result, err := s.resolve(ctx, req.FlagKey, req.Context)
+	if err != nil {
+		return nil, err
+	}
+
+	value, ok := result.Value.(float64)
+	if !ok {
+		return nil, typeMismatch(result, "float")
+	}
+
+	return &flagd.ResolveFloatResponse{
+		Value:   value,
+		Reason:  result.Reason,
+		Variant: result.Variant,
+	}, nil
+}
+
+func (s *FlagdServer) ResolveInt(ctx context.Context, req *flagd.ResolveIntRequest) (*flagd.ResolveIntResponse, error) {
+	result, err := s.resolve(ctx, req.FlagKey, req.Context)
+	if err != nil {
+		return nil, err
+	}
+
+	value, ok := result.Value.(float64)
+	if !ok || value != math.Trunc(value) {
+		return nil, typeMismatch(result, "integer")
+	}
+
+	return &flagd.ResolveIntResponse{
+		Value:   int64(value),
+		Reason:  result.Reason,
+		Variant: result.Variant,
+	}, nil
+}
+
+func (s *FlagdServer) ResolveObject(ctx context.Context, req *flagd.ResolveObjectRequest) (*flagd.ResolveObjectResponse, error) {
+	result, err := s.resolve(ctx, req.FlagKey, req.Context)
+	if err != nil {
+		return nil, err
+	}
+
+	object, ok := result.Value.(map[string]any)
+	if !ok {
+		return nil, typeMismatch(result, "object")
+	}
+
+	value, err := structpb.NewStruct(object)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &flagd.ResolveObjectResponse{
+		Value:   value,
+		Reason:  result.Reason,
+		Variant: result.Variant,
+	}, nil
+}
+
+// ResolveAll resolves every flag of the project. Like flagd, it leaves out
+// flags that fail to resolve.
+func (s *FlagdServer) ResolveAll(ctx context.Context, req *flagd.ResolveAllRequest) (*flagd.ResolveAllResponse, error) {
+	results, err := s.evaluate(ctx, nil, req.Context)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &flagd.ResolveAllResponse{
+		Flags: make(map[string]*flagd.AnyFlag, len(results)),
+	}
+
+	for _, result := range results {
+		if result.ErrorCode != "" {
+			continue
+		}
+
+		flag := &flagd.AnyFlag{
+			Reason:  result.Reason,
+			Variant: result.Variant,
+		}
+
+		switch value := result.Value.(type) {
+		case bool:
+			flag.Value = &flagd.AnyFlag_BoolValue{BoolValue: value}
+		case string:
+			flag.Value = &flagd.AnyFlag_StringValue{StringValue: value}
+		case float64:
+			flag.Value = &flagd.AnyFlag_DoubleValue{DoubleValue: value}
+		case map[string]any:
+			object, err := structpb.NewStruct(value)
+			if err != nil {
+				continue
+			}
+
+			flag.Value = &flagd.AnyFlag_ObjectValue{ObjectValue: object}
+		default:
+			continue
+		}
+
+		resp.Flags[result.Key] = flag
+	}
+
+	return resp, nil
+}
+
+// EventStream announces the provider as ready and then sends a configuration
+// change, naming every flag, whenever what the selected project serves may
+// have changed.
+func (s *FlagdServer) EventStream(req *flagd.EventStreamRequest, stream flagd.Service_EventStreamServer) error {
+	project, environment, err := s.source(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	ch, cancel := s.bus.Subscribe(16)
+	defer cancel()
+
+	if err = stream.Send(&flagd.EventStreamResponse{Type: FlagdProviderReady}); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event, ok := <-ch:
+			if !ok {
+				return nil
+			}
+
+			if event.Project != project || event.Environment != environment {
+				continue
+			}
+
+			switch event.Type {
+			case events.Published,
+				events.Activated,
+				events.Deleted,
+				events.DependencyChanged,
+				events.RolloutChanged,
+				events.TargetingChanged,
+				events.OverrideReverted:
+			default:
+				continue
+			}
+
+			if err = stream.Send(s.configurationChange(stream.Context())); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *FlagdServer) configurationChange(ctx context.Context) *flagd.EventStreamResponse {
+	changed := map[string]any{}
+
+	if results, err := s.evaluate(ctx, nil, nil); err == nil {
+		for _, result := range results {
+			changed[result.Key] = map[string]any{"type": "update"}
+		}
+	}
+
+	data, _ := structpb.NewStruct(map[string]any{"flags": changed})
+
+	return &flagd.EventStreamResponse{
+		Type: FlagdConfigurationChange,
+		Data: data,
+	}
+}
@@ -2,10 +2,23 @@ package grpcserver
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
 
+	"github.com/osamikoyo/yoconf/auth"
 	"github.com/osamikoyo/yoconf/core"
+	"github.com/osamikoyo/yoconf/flags"
+	"github.com/osamikoyo/yoconf/format"
+	"github.com/osamikoyo/yoconf/keypath"
 	"github.com/osamikoyo/yoconf/models"
+	"github.com/osamikoyo/yoconf/patch"
 	"github.com/osamikoyo/yoconf/pb"
+	"github.com/osamikoyo/yoconf/policy"
+	"github.com/osamikoyo/yoconf/schema"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type GRPCServer struct {
@@ -20,24 +33,44 @@ func NewGRPCServer(core *core.Core) *GRPCServer {
 }
 
 func (s *GRPCServer) CreateChunk(ctx context.Context, chunk *pb.Chunk) (*pb.Resp, error) {
-	if err := s.core.NewConfig(&models.Chunk{
-		Project: chunk.Project,
-		Data:    chunk.Data,
-		Version: int(chunk.Version),
-		InUse:   chunk.InUse,
+	// Authenticated callers are the author, so reviewers know who proposed a change.
+	actor := auth.Claimed(auth.FromContext(ctx), chunk.Author)
+
+	created := &models.Chunk{
+		Project:     chunk.Project,
+		Environment: chunk.Environment,
+		Data:        chunk.Data,
+		Version:     int(chunk.Version),
+		InUse:       chunk.InUse,
+		Format:      chunk.Format,
+		Author:      actor.Name,
+	}
+
+	if err := s.core.NewConfig(created, actor, models.Precondition{
+		Version: int(chunk.ExpectedVersion),
+		Hash:    chunk.ExpectedHash,
 	}); err != nil {
 		return &pb.Resp{
 			Message: err.Error(),
 		}, err
 	}
 
+	if created.ProposalID != 0 {
+		return &pb.Resp{
+			Message: fmt.Sprintf("pending approval: proposal %d", created.ProposalID),
+		}, nil
+	}
+
 	return &pb.Resp{
 		Message: "ok",
 	}, nil
 }
 
 func (s *GRPCServer) RollOn(ctx context.Context, req *pb.RollOnRequest) (*pb.Resp, error) {
-	if err := s.core.RollOn(req.Project, int(req.Version)); err != nil {
+	if err := s.core.RollOn(req.Project, req.Environment, int(req.Version), auth.FromContext(ctx), models.Precondition{
+		Version: int(req.ExpectedVersion),
+		Hash:    req.ExpectedHash,
+	}); err != nil {
 		return &pb.Resp{
 			Message: err.Error(),
 		}, err
@@ -49,7 +82,659 @@ func (s *GRPCServer) RollOn(ctx context.Context, req *pb.RollOnRequest) (*pb.Res
 }
 
 func (s *GRPCServer) DeleteChunk(ctx context.Context, req *pb.DeleteRequest) (*pb.Resp, error) {
-if err := s.core.DeleteChunk(req.Project, int(req.Version)); err != nil {
+	if err := s.core.DeleteChunk(req.Project, req.Environment, int(req.Version), auth.FromContext(ctx)); err != nil {
+		return &pb.Resp{
+			Message: err.Error(),
+		}, err
+	}
+
+	return &pb.Resp{
+		Message: "ok",
+	}, nil
+}
+
+func (s *GRPCServer) Diff(ctx context.Context, req *pb.DiffRequest) (*pb.DiffResponse, error) {
+	result, err := s.core.Diff(req.Project, req.Environment, req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]*pb.Change, len(result.Changes))
+	for i, change := range result.Changes {
+		changes[i] = &pb.Change{
+			Path: change.Path,
+			Type: change.Type,
+			Old:  change.Old,
+			New:  change.New,
+		}
+	}
+
+	return &pb.DiffResponse{
+		Project:     result.Project,
+		Environment: result.Environment,
+		FromVersion: int32(result.FromVersion),
+		ToVersion:   int32(result.ToVersion),
+		Unified:     result.Unified,
+		Structured:  result.Structured,
+		Changes:     changes,
+	}, nil
+}
+
+func (s *GRPCServer) SetProject(ctx context.Context, project *pb.Project) (*pb.Resp, error) {
+	layers := make(map[string][]string, len(project.Layers))
+	for _, spec := range project.Layers {
+		layers[spec.Environment] = spec.Layers
+	}
+
+	if err := s.core.SetProject(&models.Project{
+		Name:          project.Name,
+		Format:        project.Format,
+		Type:          project.Type,
+		PromotionPath: project.PromotionPath,
+		Layers:        layers,
+
+		Protected:         project.Protected,
+		RequiredApprovals: int(project.RequiredApprovals),
+		Approvers:         project.Approvers,
+	}, auth.FromContext(ctx)); err != nil {
+		return &pb.Resp{
+			Message: err.Error(),
+		}, err
+	}
+
+	return &pb.Resp{
+		Message: "ok",
+	}, nil
+}
+
+func (s *GRPCServer) GetProject(ctx context.Context, req *pb.GetProjectRequest) (*pb.Project, error) {
+	project, err := s.core.GetProject(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.Project{
+		Name:          project.Name,
+		Format:        project.Format,
+		Type:          project.Type,
+		SchemaVersion: int32(project.SchemaVersion),
+		PromotionPath: project.PromotionPath,
+
+		Protected:         project.Protected,
+		RequiredApprovals: int32(project.RequiredApprovals),
+		Approvers:         project.Approvers,
+	}
+
+	for environment, layers := range project.Layers {
+		resp.Layers = append(resp.Layers, &pb.LayerSpec{
+			Environment: environment,
+			Layers:      layers,
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *GRPCServer) SetSchema(ctx context.Context, req *pb.Schema) (*pb.Schema, error) {
+	created, err := s.core.SetSchema(req.Project, req.Data, auth.FromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Schema{
+		Project: created.Project,
+		Version: int32(created.Version),
+		Data:    created.Data,
+	}, nil
+}
+
+func (s *GRPCServer) GetSchema(ctx context.Context, req *pb.GetSchemaRequest) (*pb.Schema, error) {
+	found, err := s.core.GetSchema(req.Project, int(req.Version))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Schema{
+		Project: found.Project,
+		Version: int32(found.Version),
+		Data:    found.Data,
+	}, nil
+}
+
+func ruleResults(results []policy.Result) []*pb.RuleResult {
+	resp := make([]*pb.RuleResult, len(results))
+	for i, r := range results {
+		resp[i] = &pb.RuleResult{
+			Rule:    r.Rule,
+			Mode:    r.Mode,
+			Message: r.Message,
+		}
+	}
+
+	return resp
+}
+
+func (s *GRPCServer) ValidateChunk(ctx context.Context, chunk *pb.Chunk) (*pb.ValidateResponse, error) {
+	warnings, err := s.core.Validate(&models.Chunk{
+		Project:     chunk.Project,
+		Environment: chunk.Environment,
+		Data:        chunk.Data,
+		Version:     int(chunk.Version),
+		Format:      chunk.Format,
+		Author:      chunk.Author,
+	})
+	if err == nil {
+		return &pb.ValidateResponse{
+			Valid:    true,
+			Message:  "ok",
+			Warnings: ruleResults(warnings),
+		}, nil
+	}
+
+	resp := &pb.ValidateResponse{
+		Valid:    false,
+		Message:  err.Error(),
+		Warnings: ruleResults(warnings),
+	}
+
+	var verr *schema.ValidationError
+	if errors.As(err, &verr) {
+		for _, v := range verr.Violations {
+			resp.Violations = append(resp.Violations, &pb.Violation{
+				Path:    v.Path,
+				Keyword: v.Keyword,
+				Message: v.Message,
+			})
+		}
+	}
+
+	var perr *policy.Error
+	if errors.As(err, &perr) {
+		resp.Failures = ruleResults(perr.Failures)
+	}
+
+	return resp, nil
+}
+
+func (s *GRPCServer) SetRule(ctx context.Context, rule *pb.Rule) (*pb.Resp, error) {
+	if err := s.core.SetRule(&models.Rule{
+		Project:    rule.Project,
+		Name:       rule.Name,
+		Expression: rule.Expression,
+		Message:    rule.Message,
+		Mode:       rule.Mode,
+	}, auth.FromContext(ctx)); err != nil {
+		return &pb.Resp{
+			Message: err.Error(),
+		}, err
+	}
+
+	return &pb.Resp{
+		Message: "ok",
+	}, nil
+}
+
+func (s *GRPCServer) DeleteRule(ctx context.Context, req *pb.DeleteRuleRequest) (*pb.Resp, error) {
+	if err := s.core.DeleteRule(req.Project, req.Name, auth.FromContext(ctx)); err != nil {
+		return &pb.Resp{
+			Message: err.Error(),
+		}, err
+	}
+
+	return &pb.Resp{
+		Message: "ok",
+	}, nil
+}
+
+func (s *GRPCServer) ListRules(ctx context.Context, req *pb.ListRulesRequest) (*pb.RulesResponse, error) {
+	rules, err := s.core.ListRules(req.Project)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.RulesResponse{}
+	for _, rule := range rules {
+		resp.Rules = append(resp.Rules, &pb.Rule{
+			Project:    rule.Project,
+			Name:       rule.Name,
+			Expression: rule.Expression,
+			Message:    rule.Message,
+			Mode:       rule.Mode,
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *GRPCServer) ListEnvironments(ctx context.Context, req *pb.ListEnvironmentsRequest) (*pb.EnvironmentsResponse, error) {
+	environments, err := s.core.ListEnvironments(req.Project)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.EnvironmentsResponse{
+		Project:      req.Project,
+		Environments: environments,
+	}, nil
+}
+
+func chunkToPB(chunk *models.Chunk) *pb.Chunk {
+	layers := make([]*pb.LayerVersion, len(chunk.Layers))
+	for i, layer := range chunk.Layers {
+		layers[i] = &pb.LayerVersion{
+			Environment: layer.Environment,
+			Version:     int32(layer.Version),
+		}
+	}
+
+	return &pb.Chunk{
+		Project:           chunk.Project,
+		Environment:       chunk.Environment,
+		Data:              chunk.Data,
+		Version:           int32(chunk.Version),
+		InUse:             chunk.InUse,
+		Format:            chunk.Format,
+		SchemaVersion:     int32(chunk.SchemaVersion),
+		Author:            chunk.Author,
+		SourceEnvironment: chunk.SourceEnvironment,
+		SourceVersion:     int32(chunk.SourceVersion),
+		Layers:            layers,
+		Signature:         chunk.Signature,
+		SigningKey:        chunk.SigningKey,
+		ProposalID:        uint64(chunk.ProposalID),
+		Hash:              chunk.Hash,
+	}
+}
+
+func (s *GRPCServer) Promote(ctx context.Context, req *pb.PromoteRequest) (*pb.Chunk, error) {
+	promoted, err := s.core.Promote(
+		req.Project,
+		req.SourceEnvironment,
+		int(req.Version),
+		req.TargetEnvironment,
+		auth.Claimed(auth.FromContext(ctx), req.Author),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return chunkToPB(promoted), nil
+}
+
+func (s *GRPCServer) Patch(ctx context.Context, req *pb.PatchRequest) (*pb.Chunk, error) {
+	kind, err := patch.Kind(req.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	patched, err := s.core.Patch(core.PatchRequest{
+		Project:     req.Project,
+		Environment: req.Environment,
+		Base:        int(req.BaseVersion),
+		Kind:        kind,
+		Patch:       []byte(req.Patch),
+	}, auth.Claimed(auth.FromContext(ctx), req.Author))
+	if err != nil {
+		return nil, err
+	}
+
+	return chunkToPB(patched), nil
+}
+
+func dependenciesToPB(deps []models.Dependency) []*pb.Dependency {
+	resp := make([]*pb.Dependency, len(deps))
+	for i, dep := range deps {
+		resp[i] = &pb.Dependency{
+			Project:           dep.Project,
+			Environment:       dep.Environment,
+			TargetProject:     dep.TargetProject,
+			TargetEnvironment: dep.TargetEnvironment,
+			TargetVersion:     int32(dep.TargetVersion),
+		}
+	}
+
+	return resp
+}
+
+func (s *GRPCServer) ListDependencies(ctx context.Context, req *pb.ListDependenciesRequest) (*pb.DependenciesResponse, error) {
+	uses, usedBy, err := s.core.ListDependencies(req.Project, req.Environment)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DependenciesResponse{
+		Dependencies: dependenciesToPB(uses),
+		Dependents:   dependenciesToPB(usedBy),
+	}, nil
+}
+
+func (s *GRPCServer) GetConfig(ctx context.Context, req *pb.GetConfigRequest) (*pb.Chunk, error) {
+	chunk, err := s.core.GetConfig(core.ReadRequest{
+		Project:     req.Project,
+		Environment: req.Environment,
+		ClientID:    req.ClientID,
+		Labels:      req.Labels,
+		Principal:   auth.FromContext(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Path != "" {
+		target, err := format.Normalize(req.Format)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		if chunk, err = core.SelectPath(chunk, req.Path, target); err != nil {
+			return nil, pathError(err)
+		}
+	}
+
+	return chunkToPB(chunk), nil
+}
+
+// pathError maps key path errors to status codes, so clients can tell a
+// missing key from a malformed path.
+func pathError(err error) error {
+	switch {
+	case errors.Is(err, keypath.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, keypath.ErrInvalidPath):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return err
+	}
+}
+
+func (s *GRPCServer) ListSigningKeys(ctx context.Context, req *pb.ListSigningKeysRequest) (*pb.SigningKeysResponse, error) {
+	keys, err := s.core.SigningKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]*pb.PublicKey, len(keys))
+	for i, key := range keys {
+		resp[i] = &pb.PublicKey{
+			ID:        key.ID,
+			Algorithm: key.Algorithm,
+			PublicKey: key.PublicKey,
+			Current:   key.Current,
+		}
+	}
+
+	return &pb.SigningKeysResponse{
+		Keys: resp,
+	}, nil
+}
+
+func rolloutToPB(rollout *models.Rollout) *pb.Rollout {
+	steps := make([]*pb.RolloutStep, len(rollout.Steps))
+	for i, step := range rollout.Steps {
+		steps[i] = &pb.RolloutStep{
+			Percent: int32(step.Percent),
+			At:      step.At.Unix(),
+		}
+	}
+
+	return &pb.Rollout{
+		Project:     rollout.Project,
+		Environment: rollout.Environment,
+		Version:     int32(rollout.Version),
+		Percent:     int32(rollout.Percent),
+		Steps:       steps,
+	}
+}
+
+func (s *GRPCServer) StartRollout(ctx context.Context, req *pb.Rollout) (*pb.Rollout, error) {
+	steps := make([]models.RolloutStep, len(req.Steps))
+	for i, step := range req.Steps {
+		steps[i] = models.RolloutStep{
+			Percent: int(step.Percent),
+			At:      time.Unix(step.At, 0),
+		}
+	}
+
+	rollout, err := s.core.StartRollout(&models.Rollout{
+		Project:     req.Project,
+		Environment: req.Environment,
+		Version:     int(req.Version),
+		Percent:     int(req.Percent),
+		Steps:       steps,
+	}, auth.FromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return rolloutToPB(rollout), nil
+}
+
+func (s *GRPCServer) GetRollout(ctx context.Context, req *pb.RolloutRequest) (*pb.Rollout, error) {
+	rollout, err := s.core.GetRollout(req.Project, req.Environment)
+	if err != nil {
+		return nil, err
+	}
+
+	return rolloutToPB(rollout), nil
+}
+
+func (s *GRPCServer) SetRolloutPercent(ctx context.Context, req *pb.RolloutPercentRequest) (*pb.Rollout, error) {
+	rollout, err := s.core.SetRolloutPercent(req.Project, req.Environment, int(req.Percent), auth.FromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return rolloutToPB(rollout), nil
+}
+
+func (s *GRPCServer) PromoteRollout(ctx context.Context, req *pb.RolloutRequest) (*pb.Resp, error) {
+	if err := s.core.PromoteRollout(req.Project, req.Environment, auth.FromContext(ctx)); err != nil {
+		return &pb.Resp{
+			Message: err.Error(),
+		}, err
+	}
+
+	return &pb.Resp{
+		Message: "ok",
+	}, nil
+}
+
+func (s *GRPCServer) AbortRollout(ctx context.Context, req *pb.RolloutRequest) (*pb.Resp, error) {
+	if err := s.core.AbortRollout(req.Project, req.Environment, auth.FromContext(ctx)); err != nil {
+		return &pb.Resp{
+			Message: err.Error(),
+		}, err
+	}
+
+	return &pb.Resp{
+		Message: "ok",
+	}, nil
+}
+
+func (s *GRPCServer) SetTargeting(ctx context.Context, req *pb.Targeting) (*pb.Resp, error) {
+	rules := make([]models.TargetRule, len(req.Rules))
+	for i, rule := range req.Rules {
+		rules[i] = models.TargetRule{
+			Name:     rule.Name,
+			Selector: rule.Selector,
+			Version:  int(rule.Version),
+		}
+	}
+
+	if err := s.core.SetTargeting(&models.Targeting{
+		Project:        req.Project,
+		Environment:    req.Environment,
+		Rules:          rules,
+		DefaultVersion: int(req.DefaultVersion),
+	}, auth.FromContext(ctx)); err != nil {
+		return &pb.Resp{
+			Message: err.Error(),
+		}, err
+	}
+
+	return &pb.Resp{
+		Message: "ok",
+	}, nil
+}
+
+func (s *GRPCServer) GetTargeting(ctx context.Context, req *pb.GetTargetingRequest) (*pb.Targeting, error) {
+	targeting, err := s.core.GetTargeting(req.Project, req.Environment)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]*pb.TargetRule, len(targeting.Rules))
+	for i, rule := range targeting.Rules {
+		rules[i] = &pb.TargetRule{
+			Name:     rule.Name,
+			Selector: rule.Selector,
+			Version:  int32(rule.Version),
+		}
+	}
+
+	return &pb.Targeting{
+		Project:        targeting.Project,
+		Environment:    targeting.Environment,
+		Rules:          rules,
+		DefaultVersion: int32(targeting.DefaultVersion),
+	}, nil
+}
+
+func (s *GRPCServer) EvaluateTargeting(ctx context.Context, req *pb.EvaluateTargetingRequest) (*pb.TargetingResult, error) {
+	result, err := s.core.EvaluateTargeting(req.Project, req.Environment, req.Labels)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.TargetingResult{
+		Rule:    result.Rule,
+		Version: int32(result.Version),
+		Default: result.Default,
+	}, nil
+}
+
+func scheduleToPB(schedule *models.Schedule) *pb.Schedule {
+	return &pb.Schedule{
+		ID:          uint64(schedule.ID),
+		Project:     schedule.Project,
+		Environment: schedule.Environment,
+		Version:     int32(schedule.Version),
+		At:          schedule.At.Unix(),
+		Author:      schedule.Author,
+		Status:      schedule.Status,
+		Error:       schedule.Error,
+	}
+}
+
+func (s *GRPCServer) ScheduleRollOn(ctx context.Context, req *pb.Schedule) (*pb.Schedule, error) {
+	schedule, err := s.core.ScheduleRollOn(&models.Schedule{
+		Project:     req.Project,
+		Environment: req.Environment,
+		Version:     int(req.Version),
+		At:          time.Unix(req.At, 0),
+	}, auth.FromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return scheduleToPB(schedule), nil
+}
+
+func (s *GRPCServer) ListSchedules(ctx context.Context, req *pb.ListSchedulesRequest) (*pb.SchedulesResponse, error) {
+	schedules, err := s.core.ListSchedules(req.Project, req.Environment)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]*pb.Schedule, len(schedules))
+	for i := range schedules {
+		resp[i] = scheduleToPB(&schedules[i])
+	}
+
+	return &pb.SchedulesResponse{
+		Schedules: resp,
+	}, nil
+}
+
+func (s *GRPCServer) CancelSchedule(ctx context.Context, req *pb.CancelScheduleRequest) (*pb.Resp, error) {
+	if err := s.core.CancelSchedule(uint(req.ID), auth.FromContext(ctx)); err != nil {
+		return &pb.Resp{
+			Message: err.Error(),
+		}, err
+	}
+
+	return &pb.Resp{
+		Message: "ok",
+	}, nil
+}
+
+func (s *GRPCServer) ListAudit(ctx context.Context, req *pb.ListAuditRequest) (*pb.AuditResponse, error) {
+	entries, err := s.core.ListAudit(req.Project, int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]*pb.AuditEntry, len(entries))
+	for i, entry := range entries {
+		resp[i] = &pb.AuditEntry{
+			ID:          uint64(entry.ID),
+			Time:        entry.Time.Unix(),
+			Actor:       entry.Actor,
+			Action:      entry.Action,
+			Project:     entry.Project,
+			Environment: entry.Environment,
+			Version:     int32(entry.Version),
+			Detail:      entry.Detail,
+		}
+	}
+
+	return &pb.AuditResponse{
+		Entries: resp,
+	}, nil
+}
+
+func overrideToPB(override *models.Override) *pb.Override {
+	return &pb.Override{
+		Project:         override.Project,
+		Environment:     override.Environment,
+		Version:         int32(override.Version),
+		PreviousVersion: int32(override.PreviousVersion),
+		ExpiresAt:       override.ExpiresAt.Unix(),
+		Author:          override.Author,
+	}
+}
+
+func (s *GRPCServer) StartOverride(ctx context.Context, req *pb.StartOverrideRequest) (*pb.Override, error) {
+	override, err := s.core.StartOverride(
+		req.Project,
+		req.Environment,
+		int(req.Version),
+		time.Duration(req.DurationSeconds)*time.Second,
+		auth.FromContext(ctx),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return overrideToPB(override), nil
+}
+
+func (s *GRPCServer) ExtendOverride(ctx context.Context, req *pb.ExtendOverrideRequest) (*pb.Override, error) {
+	override, err := s.core.ExtendOverride(
+		req.Project,
+		req.Environment,
+		time.Duration(req.DurationSeconds)*time.Second,
+		auth.FromContext(ctx),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return overrideToPB(override), nil
+}
+
+func (s *GRPCServer) EndOverride(ctx context.Context, req *pb.OverrideRequest) (*pb.Resp, error) {
+	if err := s.core.EndOverride(req.Project, req.Environment, auth.FromContext(ctx)); err != nil {
 		return &pb.Resp{
 			Message: err.Error(),
 		}, err
@@ -59,3 +744,211 @@ if err := s.core.DeleteChunk(req.Project, int(req.Version)); err != nil {
 		Message: "ok",
 	}, nil
 }
+
+func (s *GRPCServer) GetOverride(ctx context.Context, req *pb.OverrideRequest) (*pb.Override, error) {
+	override, err := s.core.GetOverride(req.Project, req.Environment)
+	if err != nil {
+		return nil, err
+	}
+
+	return overrideToPB(override), nil
+}
+
+func proposalToPB(proposal *models.Proposal) *pb.Proposal {
+	reviews := make([]*pb.Review, len(proposal.Reviews))
+	for i, review := range proposal.Reviews {
+		reviews[i] = &pb.Review{
+			Reviewer: review.Reviewer,
+			Decision: review.Decision,
+			Comment:  review.Comment,
+			Time:     review.Time.Unix(),
+		}
+	}
+
+	return &pb.Proposal{
+		ID:          uint64(proposal.ID),
+		Project:     proposal.Project,
+		Environment: proposal.Environment,
+		Version:     int32(proposal.Version),
+		Author:      proposal.Author,
+		Status:      proposal.Status,
+		Required:    int32(proposal.Required),
+		Reviews:     reviews,
+		CreatedAt:   proposal.CreatedAt.Unix(),
+	}
+}
+
+func (s *GRPCServer) ApproveProposal(ctx context.Context, req *pb.ReviewRequest) (*pb.Proposal, error) {
+	proposal, err := s.core.ApproveProposal(uint(req.ID), auth.FromContext(ctx), req.Comment)
+	if err != nil {
+		return nil, err
+	}
+
+	return proposalToPB(proposal), nil
+}
+
+func (s *GRPCServer) RejectProposal(ctx context.Context, req *pb.ReviewRequest) (*pb.Proposal, error) {
+	proposal, err := s.core.RejectProposal(uint(req.ID), auth.FromContext(ctx), req.Comment)
+	if err != nil {
+		return nil, err
+	}
+
+	return proposalToPB(proposal), nil
+}
+
+func (s *GRPCServer) ListProposals(ctx context.Context, req *pb.ListProposalsRequest) (*pb.ProposalsResponse, error) {
+	proposals, err := s.core.ListProposals(req.Project, req.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]*pb.Proposal, len(proposals))
+	for i := range proposals {
+		resp[i] = proposalToPB(&proposals[i])
+	}
+
+	return &pb.ProposalsResponse{
+		Proposals: resp,
+	}, nil
+}
+
+func lockToPB(lock *models.Lock) *pb.Lock {
+	return &pb.Lock{
+		Project:   lock.Project,
+		Owner:     lock.Owner,
+		Reason:    lock.Reason,
+		ExpiresAt: lock.ExpiresAt.Unix(),
+	}
+}
+
+func (s *GRPCServer) LockProject(ctx context.Context, req *pb.LockRequest) (*pb.Lock, error) {
+	lock, err := s.core.LockProject(
+		req.Project,
+		req.Reason,
+		time.Duration(req.DurationSeconds)*time.Second,
+		auth.FromContext(ctx),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return lockToPB(lock), nil
+}
+
+func (s *GRPCServer) UnlockProject(ctx context.Context, req *pb.ProjectRequest) (*pb.Resp, error) {
+	if err := s.core.UnlockProject(req.Project, auth.FromContext(ctx)); err != nil {
+		return &pb.Resp{
+			Message: err.Error(),
+		}, err
+	}
+
+	return &pb.Resp{
+		Message: "ok",
+	}, nil
+}
+
+func (s *GRPCServer) GetLock(ctx context.Context, req *pb.ProjectRequest) (*pb.Lock, error) {
+	lock, err := s.core.GetLock(req.Project)
+	if err != nil {
+		return nil, err
+	}
+
+	return lockToPB(lock), nil
+}
+
+func freezeWindowToPB(window *models.FreezeWindow) *pb.FreezeWindow {
+	return &pb.FreezeWindow{
+		ID:              uint64(window.ID),
+		Project:         window.Project,
+		Name:            window.Name,
+		Days:            window.Days,
+		Start:           window.Start,
+		DurationSeconds: int64(window.Duration / time.Second),
+		Timezone:        window.Timezone,
+		Reason:          window.Reason,
+		Author:          window.Author,
+	}
+}
+
+func (s *GRPCServer) SetFreezeWindow(ctx context.Context, req *pb.FreezeWindow) (*pb.FreezeWindow, error) {
+	window, err := s.core.SetFreezeWindow(&models.FreezeWindow{
+		ID:       uint(req.ID),
+		Project:  req.Project,
+		Name:     req.Name,
+		Days:     req.Days,
+		Start:    req.Start,
+		Duration: time.Duration(req.DurationSeconds) * time.Second,
+		Timezone: req.Timezone,
+		Reason:   req.Reason,
+	}, auth.FromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return freezeWindowToPB(window), nil
+}
+
+func (s *GRPCServer) DeleteFreezeWindow(ctx context.Context, req *pb.DeleteFreezeWindowRequest) (*pb.Resp, error) {
+	if err := s.core.DeleteFreezeWindow(uint(req.ID), auth.FromContext(ctx)); err != nil {
+		return &pb.Resp{
+			Message: err.Error(),
+		}, err
+	}
+
+	return &pb.Resp{
+		Message: "ok",
+	}, nil
+}
+
+func (s *GRPCServer) ListFreezeWindows(ctx context.Context, req *pb.ProjectRequest) (*pb.FreezeWindowsResponse, error) {
+	windows, err := s.core.ListFreezeWindows(req.Project)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]*pb.FreezeWindow, len(windows))
+	for i := range windows {
+		resp[i] = freezeWindowToPB(&windows[i])
+	}
+
+	return &pb.FreezeWindowsResponse{
+		Windows: resp,
+	}, nil
+}
+
+func (s *GRPCServer) EvaluateFlags(ctx context.Context, req *pb.EvaluateFlagsRequest) (*pb.FlagsResponse, error) {
+	results, err := s.core.EvaluateFlags(core.FlagRequest{
+		Project:     req.Project,
+		Environment: req.Environment,
+		Keys:        req.Flags,
+		Context: flags.Context{
+			TargetingKey: req.TargetingKey,
+			Attributes:   req.Attributes,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]*pb.FlagResult, len(results))
+	for i, result := range results {
+		value, err := json.Marshal(result.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		resp[i] = &pb.FlagResult{
+			Key:          result.Key,
+			Type:         result.Type,
+			Value:        string(value),
+			Variant:      result.Variant,
+			Reason:       result.Reason,
+			ErrorCode:    result.ErrorCode,
+			ErrorMessage: result.ErrorMessage,
+		}
+	}
+
+	return &pb.FlagsResponse{
+		Results: resp,
+	}, nil
+}
@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/osamikoyo/yoconf/auth"
+	"github.com/osamikoyo/yoconf/core"
+)
+
+// ConsulIndexHeader carries the revision a Consul KV response was read at.
+const ConsulIndexHeader = "X-Consul-Index"
+
+// ConsulKVHandler serves the read side of the Consul KV API under /v1/kv/.
+// The key starts with the project, ?dc= names the environment, and ?index=
+// with ?wait= turns the read into a blocking query.
+func (h *Handler) ConsulKVHandler(c echo.Context) error {
+	query := c.QueryParams()
+
+	req := core.KVRequest{
+		Key:         c.Param("*"),
+		Environment: query.Get("dc"),
+		Recurse:     query.Has("recurse"),
+		Principal:   auth.FromContext(c.Request().Context()),
+	}
+
+	if raw := query.Get("index"); raw != "" {
+		index, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil || index < 0 {
+			return c.String(http.StatusBadRequest, "invalid index")
+		}
+
+		req.Index = index
+	}
+
+	if raw := query.Get("wait"); raw != "" {
+		wait, err := time.ParseDuration(raw)
+		if err != nil {
+			return c.String(http.StatusBadRequest, "invalid wait")
+		}
+
+		req.Wait = wait
+	}
+
+	pairs, index, err := h.core.ReadKV(c.Request().Context(), req)
+	if index > 0 {
+		c.Response().Header().Set(ConsulIndexHeader, strconv.FormatInt(index, 10))
+	}
+	if err != nil {
+		if statusOf(err) == http.StatusNotFound {
+			return c.NoContent(http.StatusNotFound)
+		}
+
+		return c.String(statusOf(err), err.Error())
+	}
+
+	if query.Has("raw") && !req.Recurse {
+		return c.Blob(http.StatusOK, echo.MIMETextPlainCharsetUTF8, pairs[0].Value)
+	}
+
+	return c.JSON(http.StatusOK, pairs)
+}
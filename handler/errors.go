@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/osamikoyo/yoconf/core"
+	"github.com/osamikoyo/yoconf/flags"
+	"github.com/osamikoyo/yoconf/format"
+	"github.com/osamikoyo/yoconf/freeze"
+	"github.com/osamikoyo/yoconf/keypath"
+	"github.com/osamikoyo/yoconf/patch"
+	"github.com/osamikoyo/yoconf/policy"
+	"github.com/osamikoyo/yoconf/schema"
+	"github.com/osamikoyo/yoconf/selector"
+	"github.com/osamikoyo/yoconf/storage"
+)
+
+func statusOf(err error) int {
+	var (
+		verr *schema.ValidationError
+		perr *policy.Error
+	)
+
+	switch {
+	case errors.Is(err, storage.ErrNotFound),
+		errors.Is(err, keypath.ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, core.ErrPromotionNotAllowed),
+		errors.Is(err, core.ErrNotAuthorized):
+		return http.StatusForbidden
+	case errors.Is(err, core.ErrNilInput),
+		errors.Is(err, core.ErrInvalidVersion),
+		errors.Is(err, format.ErrUnknownFormat),
+		errors.Is(err, policy.ErrInvalidRule),
+		errors.Is(err, core.ErrInvalidLayers),
+		errors.Is(err, core.ErrInvalidRollout),
+		errors.Is(err, core.ErrInvalidTargeting),
+		errors.Is(err, core.ErrInvalidSchedule),
+		errors.Is(err, core.ErrInvalidOverride),
+		errors.Is(err, core.ErrInvalidProposal),
+		errors.Is(err, freeze.ErrInvalidWindow),
+		errors.Is(err, core.ErrInvalidProjectType),
+		errors.Is(err, core.ErrNotFlagProject),
+		errors.Is(err, keypath.ErrInvalidPath),
+		errors.Is(err, selector.ErrInvalidSelector):
+		return http.StatusBadRequest
+	case errors.Is(err, core.ErrLocked),
+		errors.Is(err, core.ErrFrozen):
+		return http.StatusLocked
+	case errors.Is(err, core.ErrConflict),
+		errors.Is(err, core.ErrApprovalRequired),
+		errors.Is(err, patch.ErrTestFailed):
+		return http.StatusConflict
+	case errors.Is(err, format.ErrInvalidData),
+		errors.Is(err, format.ErrUnsupported),
+		errors.Is(err, schema.ErrInvalidSchema),
+		errors.Is(err, flags.ErrInvalidFlags),
+		errors.Is(err, patch.ErrInvalidPatch),
+		errors.Is(err, core.ErrInvalidReference),
+		errors.Is(err, core.ErrIncludeCycle),
+		errors.As(err, &verr),
+		errors.As(err, &perr):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
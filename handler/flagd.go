@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/osamikoyo/yoconf/grpcserver"
+	"github.com/osamikoyo/yoconf/pb/flagd"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// FlagdPrefix is where the flagd evaluation service is served over HTTP, in
+// the JSON flavour of the Connect protocol flagd itself speaks: every unary
+// call is a POST to /flagd.evaluation.v1.Service/{method}. The event stream
+// is only served over gRPC.
+var FlagdPrefix = "/" + flagd.Service_ServiceDesc.ServiceName
+
+type flagdMethod func(ctx context.Context, body []byte) (proto.Message, error)
+
+// unary decodes the request of a flagd call from JSON and runs it.
+func unary[Req any, R interface {
+	*Req
+	proto.Message
+}, Resp proto.Message](call func(context.Context, R) (Resp, error)) flagdMethod {
+	return func(ctx context.Context, body []byte) (proto.Message, error) {
+		req := R(new(Req))
+		if len(body) > 0 {
+			if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(body, req); err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+		}
+
+		return call(ctx, req)
+	}
+}
+
+func flagdMethods(server flagd.ServiceServer) map[string]flagdMethod {
+	return map[string]flagdMethod{
+		"ResolveBoolean": unary(server.ResolveBoolean),
+		"ResolveString":  unary(server.ResolveString),
+		"ResolveFloat":   unary(server.ResolveFloat),
+		"ResolveInt":     unary(server.ResolveInt),
+		"ResolveObject":  unary(server.ResolveObject),
+		"ResolveAll":     unary(server.ResolveAll),
+	}
+}
+
+// connectCodes are the Connect names and HTTP statuses of the gRPC codes the
+// flagd service returns.
+var connectCodes = map[codes.Code]struct {
+	name   string
+	status int
+}{
+	codes.InvalidArgument:  {"invalid_argument", http.StatusBadRequest},
+	codes.NotFound:         {"not_found", http.StatusNotFound},
+	codes.Unauthenticated:  {"unauthenticated", http.StatusUnauthorized},
+	codes.PermissionDenied: {"permission_denied", http.StatusForbidden},
+	codes.Unimplemented:    {"unimplemented", http.StatusNotImplemented},
+	codes.DataLoss:         {"data_loss", http.StatusInternalServerError},
+	codes.Internal:         {"internal", http.StatusInternalServerError},
+}
+
+func connectError(c echo.Context, err error) error {
+	s := status.Convert(err)
+
+	code, ok := connectCodes[s.Code()]
+	if !ok {
+		code.name, code.status = "unknown", http.StatusInternalServerError
+	}
+
+	return c.JSON(code.status, map[string]string{
+		"code":    code.name,
+		"message": s.Message(),
+	})
+}
+
+// FlagdHandler serves a unary flagd call. The flag project is picked by the
+// Flagd-Selector header, as over gRPC.
+func (h *Handler) FlagdHandler(c echo.Context) error {
+	method, ok := h.flagd[c.Param("method")]
+	if !ok {
+		return connectError(c, status.Errorf(codes.Unimplemented, "unknown method %q", c.Param("method")))
+	}
+
+	body, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return connectError(c, status.Error(codes.InvalidArgument, err.Error()))
+	}
+
+	ctx := c.Request().Context()
+	if selector := c.Request().Header.Get(grpcserver.FlagdSelectorHeader); selector != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(grpcserver.FlagdSelectorHeader, selector))
+	}
+
+	resp, err := method(ctx, body)
+	if err != nil {
+		return connectError(c, err)
+	}
+
+	data, err := (protojson.MarshalOptions{EmitUnpopulated: true}).Marshal(resp)
+	if err != nil {
+		return connectError(c, status.Error(codes.Internal, err.Error()))
+	}
+
+	return c.JSONBlob(http.StatusOK, data)
+}
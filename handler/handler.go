@@ -1,36 +1,473 @@
 package handler
 
 import (
+	"io"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"github.com/osamikoyo/yoconf/auth"
 	"github.com/osamikoyo/yoconf/core"
+	"github.com/osamikoyo/yoconf/flags"
+	"github.com/osamikoyo/yoconf/format"
+	"github.com/osamikoyo/yoconf/models"
+	"github.com/osamikoyo/yoconf/patch"
+	"github.com/osamikoyo/yoconf/pb/flagd"
+	"github.com/osamikoyo/yoconf/selector"
 )
 
 type Handler struct {
-	core *core.Core
+	core  *core.Core
+	auth  *auth.Authenticator
+	flagd map[string]flagdMethod
 }
 
-func NewHandler(core *core.Core) *Handler {
-	return &Handler{
+// NewHandler serves the HTTP API. A non-nil flagd server is served over HTTP
+// as well.
+func NewHandler(core *core.Core, auth *auth.Authenticator, flagd flagd.ServiceServer) *Handler {
+	h := &Handler{
 		core: core,
+		auth: auth,
 	}
+
+	if flagd != nil {
+		h.flagd = flagdMethods(flagd)
+	}
+
+	return h
 }
 
 func (h *Handler) RegisterRouters(e *echo.Echo) {
 	e.Use(middleware.Logger())
+	e.Use(h.auth.Middleware())
 
 	e.GET("/get/:project", h.GetChunkHandler)
+	e.GET("/diff/:project", h.DiffHandler)
+	e.GET("/project/:project", h.GetProjectHandler)
+	e.GET("/schema/:project", h.GetSchemaHandler)
+	e.GET("/rules/:project", h.ListRulesHandler)
+	e.GET("/environments/:project", h.ListEnvironmentsHandler)
+	e.GET("/dependencies/:project", h.ListDependenciesHandler)
+	e.GET("/keys/signing", h.ListSigningKeysHandler)
+	e.GET("/rollout/:project", h.GetRolloutHandler)
+	e.GET("/targeting/:project", h.GetTargetingHandler)
+	e.GET("/targeting/:project/evaluate", h.EvaluateTargetingHandler)
+	e.GET("/schedules/:project", h.ListSchedulesHandler)
+	e.GET("/audit/:project", h.ListAuditHandler)
+	e.GET("/override/:project", h.GetOverrideHandler)
+	e.GET("/status/:project", h.ProjectStatusHandler)
+	e.GET("/proposals/:project", h.ListProposalsHandler)
+	e.GET("/lock/:project", h.GetLockHandler)
+	e.GET("/freezes", h.ListFreezeWindowsHandler)
+	e.GET("/flags/:project", h.EvaluateFlagsHandler)
+	e.GET("/flags/:project/:flag", h.EvaluateFlagHandler)
+	e.GET("/v1/kv/*", h.ConsulKVHandler)
+
+	e.POST("/promote/:project", h.PromoteHandler)
+	e.PATCH("/config/:project", h.PatchHandler)
+
+	if h.flagd != nil {
+		e.POST(FlagdPrefix+"/:method", h.FlagdHandler)
+	}
+
+	h.registerSpring(e)
 }
 
 func (h *Handler) GetChunkHandler(c echo.Context) error {
 	project := c.Param("project")
 
-	chunk, err := h.core.GetConfig(project)
-	if err != nil{
-		return c.String(http.StatusInternalServerError, err.Error())
+	target, err := requestedFormat(c)
+	if err != nil {
+		return c.String(statusOf(err), err.Error())
+	}
+
+	labels, err := requestLabels(c)
+	if err != nil {
+		return c.String(statusOf(err), err.Error())
+	}
+
+	chunk, err := h.core.GetConfig(core.ReadRequest{
+		Project:     project,
+		Environment: c.QueryParam("env"),
+		ClientID:    clientID(c),
+		Labels:      labels,
+		Principal:   auth.FromContext(c.Request().Context()),
+	})
+	if err != nil {
+		return c.String(statusOf(err), err.Error())
+	}
+
+	// Read-modify-write tools send these back as the expected version or hash.
+	c.Response().Header().Set("X-Config-Version", strconv.Itoa(chunk.Version))
+	if chunk.Hash != "" {
+		c.Response().Header().Set("ETag", strconv.Quote(chunk.Hash))
+	}
+
+	// ?path= narrows the config down to one value or subtree.
+	if path := c.QueryParam("path"); path != "" {
+		selected, err := core.SelectPath(chunk, path, target)
+		if err != nil {
+			return c.String(statusOf(err), err.Error())
+		}
+
+		return c.Blob(http.StatusOK, format.MediaType(selected.Format), []byte(selected.Data))
+	}
+
+	if target == "" {
+		return c.JSON(http.StatusOK, chunk)
+	}
+
+	data, err := format.Convert(chunk.Data, chunk.Format, target)
+	if err != nil {
+		return c.String(statusOf(err), err.Error())
+	}
+
+	return c.Blob(http.StatusOK, format.MediaType(target), []byte(data))
+}
+
+// clientID identifies the caller for rollouts, from ?client_id= or the
+// X-Client-ID header.
+func clientID(c echo.Context) string {
+	if id := c.QueryParam("client_id"); id != "" {
+		return id
+	}
+
+	return c.Request().Header.Get("X-Client-ID")
+}
+
+// requestLabels reads client labels such as region=eu,cluster=blue from
+// ?labels= or the X-Client-Labels header.
+func requestLabels(c echo.Context) (map[string]string, error) {
+	raw := c.QueryParam("labels")
+	if raw == "" {
+		raw = c.Request().Header.Get("X-Client-Labels")
+	}
+
+	return selector.ParseLabels(raw)
+}
+
+// requestedFormat reads the target format from ?format= or the Accept header.
+// An empty result means the chunk itself should be returned as JSON.
+func requestedFormat(c echo.Context) (string, error) {
+	if query := c.QueryParam("format"); query != "" {
+		return format.Normalize(query)
+	}
+
+	target := format.FromAccept(c.Request().Header.Get(echo.HeaderAccept))
+	if target == format.JSON {
+		return "", nil
+	}
+
+	return target, nil
+}
+
+func (h *Handler) DiffHandler(c echo.Context) error {
+	project := c.Param("project")
+
+	result, err := h.core.Diff(project, c.QueryParam("env"), c.QueryParam("from"), c.QueryParam("to"))
+	if err != nil {
+		return c.String(statusOf(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+func (h *Handler) GetProjectHandler(c echo.Context) error {
+	project, err := h.core.GetProject(c.Param("project"))
+	if err != nil {
+		return c.String(statusOf(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, project)
+}
+
+func (h *Handler) GetSchemaHandler(c echo.Context) error {
+	version := 0
+	if query := c.QueryParam("version"); query != "" {
+		var err error
+		if version, err = strconv.Atoi(query); err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+	}
+
+	found, err := h.core.GetSchema(c.Param("project"), version)
+	if err != nil {
+		return c.String(statusOf(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, found)
+}
+
+func (h *Handler) ListRulesHandler(c echo.Context) error {
+	rules, err := h.core.ListRules(c.Param("project"))
+	if err != nil {
+		return c.String(statusOf(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, rules)
+}
+
+func (h *Handler) ListEnvironmentsHandler(c echo.Context) error {
+	environments, err := h.core.ListEnvironments(c.Param("project"))
+	if err != nil {
+		return c.String(statusOf(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, environments)
+}
+
+type promoteRequest struct {
+	SourceEnvironment string `json:"source_environment"`
+	Version           int    `json:"version"`
+	TargetEnvironment string `json:"target_environment"`
+	Author            string `json:"author"`
+}
+
+func (h *Handler) PromoteHandler(c echo.Context) error {
+	req := promoteRequest{}
+	if err := c.Bind(&req); err != nil {
+		return c.String(http.StatusBadRequest, err.Error())
+	}
+
+	promoted, err := h.core.Promote(
+		c.Param("project"),
+		req.SourceEnvironment,
+		req.Version,
+		req.TargetEnvironment,
+		auth.Claimed(auth.FromContext(c.Request().Context()), req.Author),
+	)
+	if err != nil {
+		return c.String(statusOf(err), err.Error())
+	}
+
+	return c.JSON(http.StatusCreated, promoted)
+}
+
+// PatchHandler applies the request body to the version named by ?base= or
+// the X-Config-Version header. The Content-Type picks a JSON patch
+// (application/json-patch+json) or a merge patch (application/merge-patch+json).
+func (h *Handler) PatchHandler(c echo.Context) error {
+	raw := c.QueryParam("base")
+	if raw == "" {
+		raw = c.Request().Header.Get("X-Config-Version")
+	}
+
+	base, err := strconv.Atoi(raw)
+	if err != nil {
+		return c.String(http.StatusBadRequest, "invalid base version")
+	}
+
+	kind, err := patch.Kind(c.Request().Header.Get(echo.HeaderContentType))
+	if err != nil {
+		return c.String(statusOf(err), err.Error())
+	}
+
+	body, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return c.String(http.StatusBadRequest, err.Error())
+	}
+
+	patched, err := h.core.Patch(core.PatchRequest{
+		Project:     c.Param("project"),
+		Environment: c.QueryParam("env"),
+		Base:        base,
+		Kind:        kind,
+		Patch:       body,
+	}, auth.Claimed(auth.FromContext(c.Request().Context()), c.QueryParam("author")))
+	if err != nil {
+		return c.String(statusOf(err), err.Error())
+	}
+
+	c.Response().Header().Set("X-Config-Version", strconv.Itoa(patched.Version))
+	if patched.Hash != "" {
+		c.Response().Header().Set("ETag", strconv.Quote(patched.Hash))
+	}
+
+	return c.JSON(http.StatusCreated, patched)
+}
+
+func (h *Handler) ListDependenciesHandler(c echo.Context) error {
+	uses, usedBy, err := h.core.ListDependencies(c.Param("project"), c.QueryParam("env"))
+	if err != nil {
+		return c.String(statusOf(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, map[string][]models.Dependency{
+		"dependencies": uses,
+		"dependents":   usedBy,
+	})
+}
+
+func (h *Handler) ListSigningKeysHandler(c echo.Context) error {
+	keys, err := h.core.SigningKeys()
+	if err != nil {
+		return c.String(statusOf(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, keys)
+}
+
+func (h *Handler) GetRolloutHandler(c echo.Context) error {
+	rollout, err := h.core.GetRollout(c.Param("project"), c.QueryParam("env"))
+	if err != nil {
+		return c.String(statusOf(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, rollout)
+}
+
+func (h *Handler) GetTargetingHandler(c echo.Context) error {
+	targeting, err := h.core.GetTargeting(c.Param("project"), c.QueryParam("env"))
+	if err != nil {
+		return c.String(statusOf(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, targeting)
+}
+
+func (h *Handler) EvaluateTargetingHandler(c echo.Context) error {
+	labels, err := requestLabels(c)
+	if err != nil {
+		return c.String(statusOf(err), err.Error())
+	}
+
+	result, err := h.core.EvaluateTargeting(c.Param("project"), c.QueryParam("env"), labels)
+	if err != nil {
+		return c.String(statusOf(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+func (h *Handler) ListSchedulesHandler(c echo.Context) error {
+	schedules, err := h.core.ListSchedules(c.Param("project"), c.QueryParam("env"))
+	if err != nil {
+		return c.String(statusOf(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, schedules)
+}
+
+func (h *Handler) ListAuditHandler(c echo.Context) error {
+	limit := 0
+	if raw := c.QueryParam("limit"); raw != "" {
+		var err error
+		if limit, err = strconv.Atoi(raw); err != nil {
+			return c.String(http.StatusBadRequest, "invalid limit")
+		}
+	}
+
+	entries, err := h.core.ListAudit(c.Param("project"), limit)
+	if err != nil {
+		return c.String(statusOf(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, entries)
+}
+
+func (h *Handler) GetOverrideHandler(c echo.Context) error {
+	override, err := h.core.GetOverride(c.Param("project"), c.QueryParam("env"))
+	if err != nil {
+		return c.String(statusOf(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, override)
+}
+
+func (h *Handler) ProjectStatusHandler(c echo.Context) error {
+	status, err := h.core.ProjectStatus(c.Param("project"))
+	if err != nil {
+		return c.String(statusOf(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, status)
+}
+
+func (h *Handler) ListProposalsHandler(c echo.Context) error {
+	proposals, err := h.core.ListProposals(c.Param("project"), c.QueryParam("status"))
+	if err != nil {
+		return c.String(statusOf(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, proposals)
+}
+
+func (h *Handler) GetLockHandler(c echo.Context) error {
+	lock, err := h.core.GetLock(c.Param("project"))
+	if err != nil {
+		return c.String(statusOf(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, lock)
+}
+
+// ListFreezeWindowsHandler lists the global freeze windows, plus those of
+// ?project= when given.
+func (h *Handler) ListFreezeWindowsHandler(c echo.Context) error {
+	windows, err := h.core.ListFreezeWindows(c.QueryParam("project"))
+	if err != nil {
+		return c.String(statusOf(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, windows)
+}
+
+// flagRequest reads the evaluation context of a flag request: the client ID
+// is the targeting key and the client labels are its attributes.
+func flagRequest(c echo.Context, keys []string) (core.FlagRequest, error) {
+	labels, err := requestLabels(c)
+	if err != nil {
+		return core.FlagRequest{}, err
+	}
+
+	return core.FlagRequest{
+		Project:     c.Param("project"),
+		Environment: c.QueryParam("env"),
+		Keys:        keys,
+		Context: flags.Context{
+			TargetingKey: clientID(c),
+			Attributes:   labels,
+		},
+	}, nil
+}
+
+// EvaluateFlagsHandler evaluates every flag of a project, or those listed
+// in ?flags=a,b.
+func (h *Handler) EvaluateFlagsHandler(c echo.Context) error {
+	var keys []string
+	if raw := c.QueryParam("flags"); raw != "" {
+		keys = strings.Split(raw, ",")
+	}
+
+	req, err := flagRequest(c, keys)
+	if err != nil {
+		return c.String(statusOf(err), err.Error())
+	}
+
+	results, err := h.core.EvaluateFlags(req)
+	if err != nil {
+		return c.String(statusOf(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, results)
+}
+
+func (h *Handler) EvaluateFlagHandler(c echo.Context) error {
+	req, err := flagRequest(c, []string{c.Param("flag")})
+	if err != nil {
+		return c.String(statusOf(err), err.Error())
+	}
+
+	results, err := h.core.EvaluateFlags(req)
+	if err != nil {
+		return c.String(statusOf(err), err.Error())
+	}
+
+	if results[0].ErrorCode == flags.ErrorFlagNotFound {
+		return c.JSON(http.StatusNotFound, results[0])
 	}
 
-	return c.JSON(http.StatusOK, chunk)
+	return c.JSON(http.StatusOK, results[0])
 }
@@ -0,0 +1,103 @@
+package handler
+
+import (
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/osamikoyo/yoconf/auth"
+	"github.com/osamikoyo/yoconf/core"
+	"github.com/osamikoyo/yoconf/format"
+	"github.com/osamikoyo/yoconf/models"
+)
+
+// SpringPrefix is the base path Spring Cloud Config clients point their
+// spring.cloud.config.uri at.
+const SpringPrefix = "/spring"
+
+// springFormats are the file variants a config server serves documents in.
+var springFormats = map[string]string{
+	".yml":        format.YAML,
+	".yaml":       format.YAML,
+	".properties": format.Properties,
+	".json":       format.JSON,
+}
+
+func (h *Handler) registerSpring(e *echo.Echo) {
+	spring := e.Group(SpringPrefix)
+
+	spring.GET("/:name", h.SpringFileHandler)
+	spring.GET("/:name/:profile", h.SpringEnvironmentHandler)
+	spring.GET("/:name/:profile/:label", h.SpringEnvironmentHandler)
+}
+
+func springRequest(c echo.Context, application, profiles, label string) core.SpringRequest {
+	return core.SpringRequest{
+		Application: application,
+		Profiles:    strings.Split(profiles, ","),
+		Label:       label,
+		Principal:   auth.FromContext(c.Request().Context()),
+	}
+}
+
+// springFile splits a file name such as orders-dev,eu.yml into the
+// application, its profiles and the format, reporting false for a name that
+// is not a document. The last dash separates the profiles, so application
+// names may hold dashes of their own.
+func springFile(name string) (application, profiles, target string, ok bool) {
+	target, ok = springFormats[path.Ext(name)]
+	if !ok {
+		return "", "", "", false
+	}
+
+	base := strings.TrimSuffix(name, path.Ext(name))
+
+	application, profiles = base, models.DefaultEnvironment
+	if index := strings.LastIndex(base, "-"); index >= 0 {
+		application, profiles = base[:index], base[index+1:]
+	}
+
+	return application, profiles, target, application != ""
+}
+
+// SpringEnvironmentHandler serves /{application}/{profile}/{label} as the
+// property sources JSON. The two segment form doubles as
+// /{label}/{application}-{profile}.yml, told apart by the file extension.
+func (h *Handler) SpringEnvironmentHandler(c echo.Context) error {
+	if application, profiles, target, ok := springFile(c.Param("profile")); ok && c.Param("label") == "" {
+		return h.springDocument(c, springRequest(c, application, profiles, c.Param("name")), target)
+	}
+
+	result, err := h.core.SpringEnvironment(springRequest(c, c.Param("name"), c.Param("profile"), c.Param("label")))
+	if err != nil {
+		return c.String(statusOf(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// SpringFileHandler serves /{application}-{profile}.yml and its .yaml,
+// .properties and .json variants from the active versions.
+func (h *Handler) SpringFileHandler(c echo.Context) error {
+	application, profiles, target, ok := springFile(c.Param("name"))
+	if !ok {
+		return c.String(http.StatusNotFound, "not found")
+	}
+
+	return h.springDocument(c, springRequest(c, application, profiles, ""), target)
+}
+
+func (h *Handler) springDocument(c echo.Context, req core.SpringRequest, target string) error {
+	doc, err := h.core.SpringDocument(req)
+	if err != nil {
+		return c.String(statusOf(err), err.Error())
+	}
+
+	data, err := format.Encode(target, doc)
+	if err != nil {
+		return c.String(statusOf(err), err.Error())
+	}
+
+	return c.Blob(http.StatusOK, format.MediaType(target), []byte(data))
+}
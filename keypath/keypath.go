@@ -0,0 +1,125 @@
+package keypath
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidPath = errors.New("invalid path")
+	ErrNotFound    = errors.New("path not found")
+)
+
+// Split breaks a dotted path such as "database.hosts[0].port" into segments.
+// List indices become their own segments. JSONPath forms such as
+// "$.database.hosts[0]" or "$['database']['port']" are accepted as well,
+// without wildcards, filters or recursive descent; "$" alone is the root.
+func Split(path string) ([]string, error) {
+	invalid := fmt.Errorf("%w: %q", ErrInvalidPath, path)
+
+	if rest, ok := strings.CutPrefix(path, "$"); ok {
+		rest, dotted := strings.CutPrefix(rest, ".")
+		if rest == "" && !dotted {
+			return []string{}, nil
+		}
+
+		path = rest
+	}
+
+	segments := []string{}
+	current := strings.Builder{}
+
+	flush := func() {
+		if current.Len() > 0 {
+			segments = append(segments, current.String())
+			current.Reset()
+		}
+	}
+
+	for i := 0; i < len(path); i++ {
+		switch path[i] {
+		case '.':
+			if i == len(path)-1 || (current.Len() == 0 && (i == 0 || path[i-1] != ']')) {
+				return nil, invalid
+			}
+
+			flush()
+		case '[':
+			flush()
+
+			end := strings.IndexByte(path[i:], ']')
+			if end <= 1 {
+				return nil, invalid
+			}
+
+			segment := unquote(path[i+1 : i+end])
+			if segment == "" || segment == "*" {
+				return nil, invalid
+			}
+
+			segments = append(segments, segment)
+			i += end
+		default:
+			current.WriteByte(path[i])
+		}
+	}
+
+	flush()
+
+	if len(segments) == 0 {
+		return nil, invalid
+	}
+
+	return segments, nil
+}
+
+// unquote strips the quotes of a JSONPath member name such as 'port'.
+func unquote(segment string) string {
+	if len(segment) >= 2 && (segment[0] == '\'' || segment[0] == '"') && segment[len(segment)-1] == segment[0] {
+		return segment[1 : len(segment)-1]
+	}
+
+	return segment
+}
+
+func Get(value any, path string) (any, error) {
+	if path == "" {
+		return value, nil
+	}
+
+	segments, err := Split(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetSegments(value, segments)
+}
+
+func GetSegments(value any, segments []string) (any, error) {
+	current := value
+
+	for i, segment := range segments {
+		switch node := current.(type) {
+		case map[string]any:
+			next, ok := node[segment]
+			if !ok {
+				return nil, fmt.Errorf("%w: %s", ErrNotFound, strings.Join(segments[:i+1], "."))
+			}
+
+			current = next
+		case []any:
+			index, err := strconv.Atoi(segment)
+			if err != nil || index < 0 || index >= len(node) {
+				return nil, fmt.Errorf("%w: %s", ErrNotFound, strings.Join(segments[:i+1], "."))
+			}
+
+			current = node[index]
+		default:
+			return nil, fmt.Errorf("%w: %s", ErrNotFound, strings.Join(segments[:i+1], "."))
+		}
+	}
+
+	return current, nil
+}
@@ -0,0 +1,85 @@
+package keypath
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		path string
+		want []string
+	}{
+		{"database", []string{"database"}},
+		{"database.hosts[0].port", []string{"database", "hosts", "0", "port"}},
+		{"a[1][2]", []string{"a", "1", "2"}},
+		{"$", []string{}},
+		{"$.database.hosts[0]", []string{"database", "hosts", "0"}},
+		{"$['database']['port']", []string{"database", "port"}},
+		{`$["a.b"]`, []string{"a.b"}},
+		{"$database", []string{"database"}},
+	}
+
+	for _, tt := range tests {
+		got, err := Split(tt.path)
+		if err != nil {
+			t.Errorf("Split(%q) error = %v", tt.path, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Split(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestSplitInvalid(t *testing.T) {
+	for _, path := range []string{"", ".a", "a.", "a..b", "a[]", "a[*]", "a[0", "$.", "$..a", "$.a[*]"} {
+		if _, err := Split(path); !errors.Is(err, ErrInvalidPath) {
+			t.Errorf("Split(%q) error = %v, want %v", path, err, ErrInvalidPath)
+		}
+	}
+}
+
+func TestGet(t *testing.T) {
+	value := map[string]any{
+		"database": map[string]any{
+			"hosts": []any{
+				map[string]any{"name": "db1", "port": 5432},
+				map[string]any{"name": "db2", "port": 5433},
+			},
+		},
+		"name": "app",
+	}
+
+	tests := []struct {
+		path string
+		want any
+		err  error
+	}{
+		{"", value, nil},
+		{"$", value, nil},
+		{"name", "app", nil},
+		{"database.hosts[1].port", 5433, nil},
+		{"$.database.hosts[0]['name']", "db1", nil},
+		{"database.hosts[2]", nil, ErrNotFound},
+		{"database.hosts[-1]", nil, ErrNotFound},
+		{"database.hosts.first", nil, ErrNotFound},
+		{"name.first", nil, ErrNotFound},
+		{"missing", nil, ErrNotFound},
+		{"database..hosts", nil, ErrInvalidPath},
+	}
+
+	for _, tt := range tests {
+		got, err := Get(value, tt.path)
+		if !errors.Is(err, tt.err) {
+			t.Errorf("Get(%q) error = %v, want %v", tt.path, err, tt.err)
+			continue
+		}
+
+		if tt.err == nil && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Get(%q) = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+}
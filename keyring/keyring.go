@@ -0,0 +1,98 @@
+package keyring
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+const KeySize = 32
+
+var (
+	ErrUnknownKey = errors.New("unknown key")
+	ErrInvalidKey = errors.New("invalid key")
+)
+
+// KeyProvider hands out symmetric keys by id. The current key is used for
+// new encryptions, older keys stay available for decryption.
+type KeyProvider interface {
+	Current() (string, []byte, error)
+	Key(id string) ([]byte, error)
+	IDs() []string
+}
+
+type keyFile struct {
+	Current string            `yaml:"current"`
+	Keys    map[string]string `yaml:"keys"`
+}
+
+// FileProvider reads base64 encoded 256-bit keys from a local YAML keyfile:
+//
+//	current: k2
+//	keys:
+//	  k1: <base64>
+//	  k2: <base64>
+type FileProvider struct {
+	current string
+	keys    map[string][]byte
+}
+
+func NewFileProvider(path string) (*FileProvider, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed read keyfile: %v", err)
+	}
+
+	file := keyFile{}
+	if err = yaml.Unmarshal(raw, &file); err != nil {
+		return nil, fmt.Errorf("failed decode keyfile: %v", err)
+	}
+
+	provider := &FileProvider{
+		current: file.Current,
+		keys:    make(map[string][]byte, len(file.Keys)),
+	}
+
+	for id, encoded := range file.Keys {
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil || len(key) != KeySize {
+			return nil, fmt.Errorf("%w: %q must be %d base64 encoded bytes", ErrInvalidKey, id, KeySize)
+		}
+
+		provider.keys[id] = key
+	}
+
+	if _, ok := provider.keys[provider.current]; !ok {
+		return nil, fmt.Errorf("%w: current key %q", ErrUnknownKey, provider.current)
+	}
+
+	return provider, nil
+}
+
+func (p *FileProvider) Current() (string, []byte, error) {
+	return p.current, p.keys[p.current], nil
+}
+
+func (p *FileProvider) Key(id string) ([]byte, error) {
+	key, ok := p.keys[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownKey, id)
+	}
+
+	return key, nil
+}
+
+func (p *FileProvider) IDs() []string {
+	ids := make([]string, 0, len(p.keys))
+	for id := range p.keys {
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+
+	return ids
+}
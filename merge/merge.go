@@ -0,0 +1,116 @@
+// Package merge deep-merges parsed config layers.
+//
+// Maps are merged key by key, with the overlay winning on conflicts. Lists and
+// scalars from the overlay replace the base value. Overlays can steer the merge
+// with a few directives:
+//
+//	key: $delete        removes key from the result
+//	key+: [c, d]        appends to the base list at key instead of replacing it
+//	$replace: true      inside a map, replaces the base map instead of merging
+package merge
+
+import "strings"
+
+const (
+	Delete       = "$delete"
+	Replace      = "$replace"
+	AppendSuffix = "+"
+)
+
+// Merge returns base with overlay applied. Neither argument is modified.
+func Merge(base, overlay any) any {
+	overlayMap, ok := overlay.(map[string]any)
+	if !ok {
+		return strip(overlay)
+	}
+
+	baseMap, ok := base.(map[string]any)
+	if !ok || overlayMap[Replace] == true {
+		return strip(overlay)
+	}
+
+	result := make(map[string]any, len(baseMap)+len(overlayMap))
+	for key, value := range baseMap {
+		result[key] = value
+	}
+
+	for key, value := range overlayMap {
+		if key == Replace {
+			continue
+		}
+
+		if value == Delete {
+			delete(result, key)
+			continue
+		}
+
+		if name, ok := strings.CutSuffix(key, AppendSuffix); ok && name != "" {
+			if list, ok := value.([]any); ok {
+				existing, _ := result[name].([]any)
+
+				merged := make([]any, 0, len(existing)+len(list))
+				merged = append(merged, existing...)
+				merged = append(merged, strip(list).([]any)...)
+				result[name] = merged
+
+				continue
+			}
+		}
+
+		if current, exists := result[key]; exists {
+			result[key] = Merge(current, value)
+		} else {
+			result[key] = strip(value)
+		}
+	}
+
+	return result
+}
+
+// All merges layers in order, from the base to the most specific overlay.
+func All(layers ...any) any {
+	var result any
+	for i, layer := range layers {
+		if i == 0 {
+			result = strip(layer)
+			continue
+		}
+
+		result = Merge(result, layer)
+	}
+
+	return result
+}
+
+// strip copies a value while dropping merge directives, so that a layer that
+// is used on its own never leaks them into the output.
+func strip(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		for key, val := range v {
+			if key == Replace || val == Delete {
+				continue
+			}
+
+			if name, ok := strings.CutSuffix(key, AppendSuffix); ok && name != "" {
+				if _, isList := val.([]any); isList {
+					key = name
+				}
+			}
+
+			result[key] = strip(val)
+		}
+
+		return result
+	case []any:
+		result := make([]any, len(v))
+		for i, val := range v {
+			result[i] = strip(val)
+		}
+
+		return result
+	default:
+		return v
+	}
+}
@@ -0,0 +1,108 @@
+package merge
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMerge(t *testing.T) {
+	tests := []struct {
+		name    string
+		base    any
+		overlay any
+		want    any
+	}{
+		{
+			name:    "nested maps",
+			base:    map[string]any{"db": map[string]any{"host": "a", "port": 1}},
+			overlay: map[string]any{"db": map[string]any{"host": "b"}},
+			want:    map[string]any{"db": map[string]any{"host": "b", "port": 1}},
+		},
+		{
+			name:    "lists replace",
+			base:    map[string]any{"hosts": []any{"a", "b"}},
+			overlay: map[string]any{"hosts": []any{"c"}},
+			want:    map[string]any{"hosts": []any{"c"}},
+		},
+		{
+			name:    "append",
+			base:    map[string]any{"hosts": []any{"a"}},
+			overlay: map[string]any{"hosts+": []any{"b", "c"}},
+			want:    map[string]any{"hosts": []any{"a", "b", "c"}},
+		},
+		{
+			name:    "append to nothing",
+			base:    map[string]any{},
+			overlay: map[string]any{"hosts+": []any{"b"}},
+			want:    map[string]any{"hosts": []any{"b"}},
+		},
+		{
+			name:    "delete",
+			base:    map[string]any{"a": 1, "b": 2},
+			overlay: map[string]any{"a": Delete},
+			want:    map[string]any{"b": 2},
+		},
+		{
+			name:    "replace map",
+			base:    map[string]any{"db": map[string]any{"host": "a", "port": 1}},
+			overlay: map[string]any{"db": map[string]any{Replace: true, "host": "b"}},
+			want:    map[string]any{"db": map[string]any{"host": "b"}},
+		},
+		{
+			name:    "scalar over map",
+			base:    map[string]any{"db": map[string]any{"host": "a"}},
+			overlay: map[string]any{"db": "off"},
+			want:    map[string]any{"db": "off"},
+		},
+		{
+			name:    "directives in new keys",
+			base:    map[string]any{},
+			overlay: map[string]any{"db": map[string]any{"gone": Delete, "hosts+": []any{"a"}}},
+			want:    map[string]any{"db": map[string]any{"hosts": []any{"a"}}},
+		},
+		{
+			name:    "nil base",
+			base:    nil,
+			overlay: map[string]any{"a": 1},
+			want:    map[string]any{"a": 1},
+		},
+	}
+
+	for _, tt := range tests {
+		if got := Merge(tt.base, tt.overlay); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: Merge() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestMergeKeepsArguments(t *testing.T) {
+	base := map[string]any{"db": map[string]any{"host": "a"}, "hosts": []any{"a"}}
+	overlay := map[string]any{"db": map[string]any{"host": "b"}, "hosts+": []any{"b"}, "x": Delete}
+
+	Merge(base, overlay)
+
+	if !reflect.DeepEqual(base, map[string]any{"db": map[string]any{"host": "a"}, "hosts": []any{"a"}}) {
+		t.Errorf("Merge() changed base to %v", base)
+	}
+
+	if !reflect.DeepEqual(overlay, map[string]any{"db": map[string]any{"host": "b"}, "hosts+": []any{"b"}, "x": Delete}) {
+		t.Errorf("Merge() changed overlay to %v", overlay)
+	}
+}
+
+func TestAll(t *testing.T) {
+	got := All(
+		map[string]any{"a": 1, "b": map[string]any{"c": 2}, "gone": Delete},
+		map[string]any{"b": map[string]any{"d": 3}},
+		map[string]any{"a": Delete},
+	)
+	want := map[string]any{"b": map[string]any{"c": 2, "d": 3}}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("All() = %v, want %v", got, want)
+	}
+
+	if got := All(); got != nil {
+		t.Errorf("All() of nothing = %v", got)
+	}
+}
@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+// AuditEntry records who changed what and when. Entries are only appended.
+type AuditEntry struct {
+	ID          uint      `json:"id" gorm:"primaryKey"`
+	Time        time.Time `json:"time" gorm:"index"`
+	Actor       string    `json:"actor"`
+	Action      string    `json:"action"`
+	Project     string    `json:"project" gorm:"index"`
+	Environment string    `json:"environment,omitempty"`
+	Version     int       `json:"version,omitempty"`
+	Detail      string    `json:"detail,omitempty"`
+}
@@ -1,8 +1,42 @@
 package models
 
+const DefaultEnvironment = "default"
+
 type Chunk struct {
-	Project string `json:"project"`
-	InUse   bool   `json:"in_use"`
-	Data    string `json:"data"`
-	Version int    `json:"version"`
+	Project     string `json:"project"`
+	Environment string `json:"environment" gorm:"default:default"`
+	InUse       bool   `json:"in_use"`
+	Data        string `json:"data"`
+	Version     int    `json:"version"`
+	Format      string `json:"format,omitempty"`
+	Author      string `json:"author,omitempty"`
+
+	SchemaVersion int `json:"schema_version,omitempty"`
+
+	SourceEnvironment string `json:"source_environment,omitempty"`
+	SourceVersion     int    `json:"source_version,omitempty"`
+
+	ProposalID uint `json:"proposal_id,omitempty"`
+
+	// Hash is the ContentHash of the version's own data as revealed to readers,
+	// also when reads return it merged with layers or includes.
+	Hash string `json:"hash,omitempty"`
+
+	Signature  string `json:"signature,omitempty"`
+	SigningKey string `json:"signing_key,omitempty"`
+
+	Layers []LayerVersion `json:"layers,omitempty" gorm:"-"`
+}
+
+type LayerVersion struct {
+	Environment string `json:"environment"`
+	Version     int    `json:"version"`
+}
+
+func EnvironmentOrDefault(environment string) string {
+	if environment == "" {
+		return DefaultEnvironment
+	}
+
+	return environment
 }
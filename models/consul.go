@@ -0,0 +1,12 @@
+package models
+
+// KVPair is a key as the Consul KV API returns it. Value is base64 encoded in
+// JSON, like Consul does.
+type KVPair struct {
+	LockIndex   uint64 `json:"LockIndex"`
+	Key         string `json:"Key"`
+	Flags       uint64 `json:"Flags"`
+	Value       []byte `json:"Value"`
+	CreateIndex uint64 `json:"CreateIndex"`
+	ModifyIndex uint64 `json:"ModifyIndex"`
+}
@@ -0,0 +1,9 @@
+package models
+
+type Dependency struct {
+	Project           string `json:"project" gorm:"primaryKey"`
+	Environment       string `json:"environment" gorm:"primaryKey"`
+	TargetProject     string `json:"target_project" gorm:"primaryKey"`
+	TargetEnvironment string `json:"target_environment" gorm:"primaryKey"`
+	TargetVersion     int    `json:"target_version,omitempty" gorm:"primaryKey"`
+}
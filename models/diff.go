@@ -0,0 +1,24 @@
+package models
+
+const (
+	ChangeAdded   = "added"
+	ChangeRemoved = "removed"
+	ChangeChanged = "changed"
+)
+
+type Change struct {
+	Path string `json:"path"`
+	Type string `json:"type"`
+	Old  string `json:"old,omitempty"`
+	New  string `json:"new,omitempty"`
+}
+
+type Diff struct {
+	Project     string   `json:"project"`
+	Environment string   `json:"environment"`
+	FromVersion int      `json:"from_version"`
+	ToVersion   int      `json:"to_version"`
+	Unified     string   `json:"unified"`
+	Structured  bool     `json:"structured"`
+	Changes     []Change `json:"changes,omitempty"`
+}
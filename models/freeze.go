@@ -0,0 +1,30 @@
+package models
+
+import "time"
+
+// Lock blocks changes to a project by anyone but its owner until it expires
+// or is released.
+type Lock struct {
+	Project   string    `json:"project" gorm:"primaryKey"`
+	Owner     string    `json:"owner"`
+	Reason    string    `json:"reason,omitempty"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// FreezeWindow blocks changes to a project, or to every project when Project
+// is empty, during a recurring period. It starts at Start ("15:04") on each of
+// Days ("mon".."sun", every day when empty) in Timezone and lasts Duration.
+type FreezeWindow struct {
+	ID       uint          `json:"id" gorm:"primaryKey"`
+	Project  string        `json:"project,omitempty" gorm:"index"`
+	Name     string        `json:"name"`
+	Days     []string      `json:"days,omitempty" gorm:"serializer:json"`
+	Start    string        `json:"start"`
+	Duration time.Duration `json:"duration"`
+	Timezone string        `json:"timezone,omitempty"`
+	Reason   string        `json:"reason,omitempty"`
+	Author   string        `json:"author,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -0,0 +1,10 @@
+package models
+
+// RecordKey names the master key protecting a stored chunk. An empty KeyID
+// means the record is stored in plaintext.
+type RecordKey struct {
+	Project     string `json:"project"`
+	Environment string `json:"environment"`
+	Version     int    `json:"version"`
+	KeyID       string `json:"key_id"`
+}
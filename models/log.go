@@ -0,0 +1,19 @@
+package models
+
+import (
+	"github.com/osamikoyo/yoconf/secrets"
+	"go.uber.org/zap/zapcore"
+)
+
+// MarshalLogObject keeps secret values out of logs whenever a chunk is logged.
+func (c *Chunk) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("project", c.Project)
+	enc.AddString("environment", c.Environment)
+	enc.AddInt("version", c.Version)
+	enc.AddBool("in_use", c.InUse)
+	enc.AddString("format", c.Format)
+	enc.AddString("author", c.Author)
+	enc.AddString("data", secrets.Mask(c.Data))
+
+	return nil
+}
@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// Override is a temporary activation of Version. When it expires the
+// environment goes back to PreviousVersion.
+type Override struct {
+	Project         string    `json:"project" gorm:"primaryKey"`
+	Environment     string    `json:"environment" gorm:"primaryKey"`
+	Version         int       `json:"version"`
+	PreviousVersion int       `json:"previous_version"`
+	ExpiresAt       time.Time `json:"expires_at" gorm:"index"`
+	Author          string    `json:"author,omitempty"`
+
+	LeaseOwner string    `json:"-"`
+	LeaseUntil time.Time `json:"-"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
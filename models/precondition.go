@@ -0,0 +1,40 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// Precondition guards a write against concurrent changes: it only applies
+// while the active version, or its content hash, is still the expected one.
+// Zero fields are not checked.
+type Precondition struct {
+	Version int    `json:"version,omitempty"`
+	Hash    string `json:"hash,omitempty"`
+}
+
+func (p Precondition) IsZero() bool {
+	return p.Version == 0 && p.Hash == ""
+}
+
+// Holds reports whether active satisfies the precondition. A missing active
+// version only satisfies an empty precondition.
+func (p Precondition) Holds(active *Chunk) bool {
+	if p.IsZero() {
+		return true
+	}
+
+	if active == nil {
+		return false
+	}
+
+	return (p.Version == 0 || p.Version == active.Version) &&
+		(p.Hash == "" || p.Hash == active.Hash)
+}
+
+// ContentHash is the hex encoded sha256 of config data.
+func ContentHash(data string) string {
+	sum := sha256.Sum256([]byte(data))
+
+	return hex.EncodeToString(sum[:])
+}
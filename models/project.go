@@ -0,0 +1,30 @@
+package models
+
+const (
+	ProjectTypeConfig = "config"
+	ProjectTypeFlags  = "flags"
+)
+
+type Project struct {
+	Name   string `json:"name" gorm:"primaryKey"`
+	Format string `json:"format,omitempty"`
+
+	// Type is ProjectTypeFlags for projects holding feature flags. Empty
+	// means ProjectTypeConfig.
+	Type string `json:"type,omitempty"`
+
+	SchemaVersion int `json:"schema_version,omitempty"`
+
+	PromotionPath []string `json:"promotion_path,omitempty" gorm:"serializer:json"`
+
+	// Layers lists, per environment, the environments whose active versions
+	// are merged under it, from the base to the most specific overlay.
+	Layers map[string][]string `json:"layers,omitempty" gorm:"serializer:json"`
+
+	// Protected projects only activate new versions once RequiredApprovals
+	// principals other than the author approved them. An empty Approvers list
+	// lets every principal with the approve permission review.
+	Protected         bool     `json:"protected,omitempty"`
+	RequiredApprovals int      `json:"required_approvals,omitempty"`
+	Approvers         []string `json:"approvers,omitempty" gorm:"serializer:json"`
+}
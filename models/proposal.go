@@ -0,0 +1,51 @@
+package models
+
+import "time"
+
+const (
+	ProposalPending  = "pending"
+	ProposalApproved = "approved"
+	ProposalRejected = "rejected"
+
+	ReviewApprove = "approve"
+	ReviewReject  = "reject"
+)
+
+// Proposal is a version of a protected project waiting for approval.
+// Proposer is the authenticated principal that made it, while Author is
+// only the name the version is attributed to.
+type Proposal struct {
+	ID          uint     `json:"id" gorm:"primaryKey"`
+	Project     string   `json:"project" gorm:"index"`
+	Environment string   `json:"environment"`
+	Version     int      `json:"version"`
+	Author      string   `json:"author,omitempty"`
+	Proposer    string   `json:"proposer,omitempty"`
+	Status      string   `json:"status" gorm:"index"`
+	Required    int      `json:"required"`
+	Reviews     []Review `json:"reviews,omitempty" gorm:"serializer:json"`
+
+	// Revision guards concurrent reviews of the same proposal.
+	Revision int `json:"-"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type Review struct {
+	Reviewer string    `json:"reviewer"`
+	Decision string    `json:"decision"`
+	Comment  string    `json:"comment,omitempty"`
+	Time     time.Time `json:"time"`
+}
+
+func (p *Proposal) Approvals() int {
+	approvals := 0
+	for _, review := range p.Reviews {
+		if review.Decision == ReviewApprove {
+			approvals++
+		}
+	}
+
+	return approvals
+}
@@ -0,0 +1,32 @@
+package models
+
+import "time"
+
+// Revision is one step of the global change sequence: the activation of
+// Version in an environment, or its deactivation when Version is 0. IDs only
+// grow, so they order changes across every project.
+type Revision struct {
+	ID          int64     `json:"id" gorm:"primaryKey"`
+	Project     string    `json:"project" gorm:"index:idx_revision_environment"`
+	Environment string    `json:"environment" gorm:"index:idx_revision_environment"`
+	Version     int       `json:"version"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// KeyValue is a key of the etcd compatible API: a project, or a path inside
+// its served config.
+type KeyValue struct {
+	Key            string `json:"key"`
+	Value          []byte `json:"value"`
+	CreateRevision int64  `json:"create_revision"`
+	ModRevision    int64  `json:"mod_revision"`
+	Version        int    `json:"version"`
+}
+
+// KeyEvent is a change of a key seen by an etcd watch. Prev holds the key as
+// it was before the change, and is nil for a new key.
+type KeyEvent struct {
+	Deleted bool      `json:"deleted"`
+	KV      KeyValue  `json:"kv"`
+	Prev    *KeyValue `json:"prev,omitempty"`
+}
@@ -0,0 +1,27 @@
+package models
+
+import "time"
+
+// Rollout serves Version to Percent of the clients of an environment, picked
+// by a sticky hash of their client id. Everyone else keeps the active version.
+type Rollout struct {
+	Project     string `json:"project" gorm:"primaryKey"`
+	Environment string `json:"environment" gorm:"primaryKey"`
+	Version     int    `json:"version"`
+	Percent     int    `json:"percent"`
+
+	// Steps raise Percent automatically once their time has come.
+	Steps []RolloutStep `json:"steps,omitempty" gorm:"serializer:json"`
+
+	// A replica holds the lease while it applies due steps.
+	LeaseOwner string    `json:"-"`
+	LeaseUntil time.Time `json:"-"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type RolloutStep struct {
+	Percent int       `json:"percent"`
+	At      time.Time `json:"at"`
+}
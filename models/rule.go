@@ -0,0 +1,9 @@
+package models
+
+type Rule struct {
+	Project    string `json:"project" gorm:"primaryKey"`
+	Name       string `json:"name" gorm:"primaryKey"`
+	Expression string `json:"expression"`
+	Message    string `json:"message,omitempty"`
+	Mode       string `json:"mode"`
+}
@@ -0,0 +1,30 @@
+package models
+
+import "time"
+
+const (
+	SchedulePending   = "pending"
+	ScheduleRunning   = "running"
+	ScheduleDone      = "done"
+	ScheduleFailed    = "failed"
+	ScheduleCancelled = "cancelled"
+)
+
+// Schedule activates a version at a set time. A replica claims a due schedule
+// by taking its lease, so only one of them runs it.
+type Schedule struct {
+	ID          uint      `json:"id" gorm:"primaryKey"`
+	Project     string    `json:"project" gorm:"index"`
+	Environment string    `json:"environment"`
+	Version     int       `json:"version"`
+	At          time.Time `json:"at" gorm:"index"`
+	Author      string    `json:"author,omitempty"`
+	Status      string    `json:"status" gorm:"index"`
+	Error       string    `json:"error,omitempty"`
+
+	LeaseOwner string     `json:"-"`
+	LeaseUntil time.Time  `json:"-"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
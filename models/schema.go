@@ -0,0 +1,7 @@
+package models
+
+type Schema struct {
+	Project string `json:"project" gorm:"primaryKey"`
+	Version int    `json:"version" gorm:"primaryKey"`
+	Data    string `json:"data"`
+}
@@ -0,0 +1,8 @@
+package models
+
+type PublicKey struct {
+	ID        string `json:"id"`
+	Algorithm string `json:"algorithm"`
+	PublicKey string `json:"public_key"`
+	Current   bool   `json:"current"`
+}
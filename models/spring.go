@@ -0,0 +1,17 @@
+package models
+
+// SpringEnvironment is the environment document a Spring Cloud Config client
+// expects from its config server.
+type SpringEnvironment struct {
+	Name            string           `json:"name"`
+	Profiles        []string         `json:"profiles"`
+	Label           *string          `json:"label"`
+	Version         *string          `json:"version"`
+	State           *string          `json:"state"`
+	PropertySources []PropertySource `json:"propertySources"`
+}
+
+type PropertySource struct {
+	Name   string            `json:"name"`
+	Source map[string]string `json:"source"`
+}
@@ -0,0 +1,18 @@
+package models
+
+// ProjectStatus sums up what every environment of a project currently serves
+// and what is about to change.
+type ProjectStatus struct {
+	Project      string              `json:"project"`
+	Lock         *Lock               `json:"lock,omitempty"`
+	Freezes      []FreezeWindow      `json:"freezes,omitempty"`
+	Environments []EnvironmentStatus `json:"environments"`
+}
+
+type EnvironmentStatus struct {
+	Environment   string     `json:"environment"`
+	ActiveVersion int        `json:"active_version,omitempty"`
+	Override      *Override  `json:"override,omitempty"`
+	Rollout       *Rollout   `json:"rollout,omitempty"`
+	Schedules     []Schedule `json:"schedules,omitempty"`
+}
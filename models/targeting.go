@@ -0,0 +1,24 @@
+package models
+
+// Targeting pins versions of an environment to clients by their labels. Rules
+// are tried in order and the first matching selector wins. Labelled clients
+// that match no rule get DefaultVersion, or the active version when it is 0.
+type Targeting struct {
+	Project        string       `json:"project" gorm:"primaryKey"`
+	Environment    string       `json:"environment" gorm:"primaryKey"`
+	Rules          []TargetRule `json:"rules" gorm:"serializer:json"`
+	DefaultVersion int          `json:"default_version,omitempty"`
+}
+
+type TargetRule struct {
+	Name     string `json:"name"`
+	Selector string `json:"selector"`
+	Version  int    `json:"version"`
+}
+
+// TargetingResult explains which version a set of labels resolves to.
+type TargetingResult struct {
+	Rule    string `json:"rule,omitempty"`
+	Version int    `json:"version"`
+	Default bool   `json:"default"`
+}
@@ -0,0 +1,549 @@
+package patch
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/osamikoyo/yoconf/format"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// JSONPatch is an RFC 6902 list of operations.
+	JSONPatch = "json-patch"
+	// MergePatch is an RFC 7396 document merged over the data.
+	MergePatch = "merge-patch"
+)
+
+var (
+	ErrInvalidPatch = errors.New("invalid patch")
+	ErrTestFailed   = errors.New("patch test failed")
+)
+
+var mediaTypes = map[string]string{
+	JSONPatch:                      JSONPatch,
+	MergePatch:                     MergePatch,
+	"application/json-patch+json":  JSONPatch,
+	"application/merge-patch+json": MergePatch,
+	"application/json":             MergePatch,
+}
+
+// Kind maps a patch kind or media type to a patch kind. A missing kind is
+// read as a merge patch.
+func Kind(name string) (string, error) {
+	if name == "" {
+		return MergePatch, nil
+	}
+
+	if media, _, err := mime.ParseMediaType(name); err == nil {
+		name = media
+	}
+
+	kind, ok := mediaTypes[strings.ToLower(name)]
+	if !ok {
+		return "", fmt.Errorf("%w: unknown patch type %q", ErrInvalidPatch, name)
+	}
+
+	return kind, nil
+}
+
+type Operation struct {
+	Op    string `json:"op"`
+	Path  string `json:"path"`
+	From  string `json:"from,omitempty"`
+	Value any    `json:"value"`
+}
+
+// Apply patches data written in dataFormat and returns it in the same format.
+// YAML is edited in place, so its comments and key order survive; other
+// formats are parsed, patched and encoded again.
+func Apply(kind, dataFormat, data string, body []byte) (string, error) {
+	if dataFormat == "" {
+		dataFormat = format.YAML
+	}
+
+	doc := &document{}
+
+	if dataFormat == format.YAML {
+		file := yaml.Node{}
+		if err := yaml.Unmarshal([]byte(data), &file); err != nil {
+			return "", fmt.Errorf("%w: %v", format.ErrInvalidData, err)
+		}
+
+		if len(file.Content) > 0 {
+			doc.root = file.Content[0]
+		}
+	} else {
+		value, err := format.Parse(dataFormat, data)
+		if err != nil {
+			return "", err
+		}
+
+		if doc.root, err = toNode(value); err != nil {
+			return "", err
+		}
+	}
+
+	switch kind {
+	case JSONPatch:
+		ops := []Operation{}
+		if err := json.Unmarshal(body, &ops); err != nil {
+			return "", fmt.Errorf("%w: %v", ErrInvalidPatch, err)
+		}
+
+		for i, op := range ops {
+			if err := doc.apply(op); err != nil {
+				return "", fmt.Errorf("operation %d (%s %s): %w", i, op.Op, op.Path, err)
+			}
+		}
+	case MergePatch:
+		var merge any
+		if err := json.Unmarshal(body, &merge); err != nil {
+			return "", fmt.Errorf("%w: %v", ErrInvalidPatch, err)
+		}
+
+		root, err := mergeNode(doc.root, merge)
+		if err != nil {
+			return "", err
+		}
+
+		doc.root = root
+	default:
+		return "", fmt.Errorf("%w: unknown patch type %q", ErrInvalidPatch, kind)
+	}
+
+	if dataFormat == format.YAML {
+		if doc.root == nil {
+			return "", nil
+		}
+
+		buf := bytes.Buffer{}
+		encoder := yaml.NewEncoder(&buf)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(doc.root); err != nil {
+			return "", err
+		}
+
+		return buf.String(), nil
+	}
+
+	value, err := fromNode(doc.root)
+	if err != nil {
+		return "", err
+	}
+
+	return format.Encode(dataFormat, value)
+}
+
+func toNode(value any) (*yaml.Node, error) {
+	node := &yaml.Node{}
+	if err := node.Encode(value); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPatch, err)
+	}
+
+	return node, nil
+}
+
+func fromNode(node *yaml.Node) (any, error) {
+	if node == nil {
+		return nil, nil
+	}
+
+	var value any
+	if err := node.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+// resolve follows aliases to the node they point at.
+func resolve(node *yaml.Node) *yaml.Node {
+	for node != nil && node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+
+	return node
+}
+
+// find returns the index of the value of key in a mapping node, or -1.
+func find(mapping *yaml.Node, key string) int {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return i + 1
+		}
+	}
+
+	return -1
+}
+
+// keep carries the comments of a replaced node over to its replacement.
+func keep(old, replacement *yaml.Node) *yaml.Node {
+	if old != nil {
+		replacement.HeadComment = old.HeadComment
+		replacement.LineComment = old.LineComment
+		replacement.FootComment = old.FootComment
+	}
+
+	return replacement
+}
+
+func mergeNode(target *yaml.Node, merge any) (*yaml.Node, error) {
+	fields, ok := merge.(map[string]any)
+	if !ok {
+		replacement, err := toNode(merge)
+		if err != nil {
+			return nil, err
+		}
+
+		return keep(target, replacement), nil
+	}
+
+	target = resolve(target)
+	if target == nil || target.Kind != yaml.MappingNode {
+		target = keep(target, &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"})
+	}
+
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		index := find(target, key)
+
+		if fields[key] == nil {
+			if index != -1 {
+				target.Content = append(target.Content[:index-1], target.Content[index+1:]...)
+			}
+
+			continue
+		}
+
+		var current *yaml.Node
+		if index != -1 {
+			current = target.Content[index]
+		}
+
+		value, err := mergeNode(current, fields[key])
+		if err != nil {
+			return nil, err
+		}
+
+		if index != -1 {
+			target.Content[index] = value
+		} else {
+			target.Content = append(target.Content,
+				&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, value)
+		}
+	}
+
+	return target, nil
+}
+
+// document is the node tree a JSON patch works on.
+type document struct {
+	root *yaml.Node
+}
+
+// pointer splits an RFC 6901 JSON pointer into its reference tokens.
+func pointer(path string) ([]string, error) {
+	if path == "" {
+		return []string{}, nil
+	}
+
+	if !strings.HasPrefix(path, "/") {
+		return nil, fmt.Errorf("%w: pointer %q does not start with /", ErrInvalidPatch, path)
+	}
+
+	tokens := strings.Split(path[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+
+	return tokens, nil
+}
+
+// index reads a sequence index, allowing "-" and len when the caller adds.
+func index(sequence *yaml.Node, token string, adding bool) (int, error) {
+	size := len(sequence.Content)
+
+	if token == "-" && adding {
+		return size, nil
+	}
+
+	i, err := strconv.Atoi(token)
+	if err != nil || i < 0 || (token != "0" && token[0] == '0') {
+		return 0, fmt.Errorf("%w: invalid index %q", ErrInvalidPatch, token)
+	}
+
+	if i > size || (i == size && !adding) {
+		return 0, fmt.Errorf("%w: index %d is out of range", ErrInvalidPatch, i)
+	}
+
+	return i, nil
+}
+
+// parent walks to the container holding the last token of path.
+func (d *document) parent(path string) (*yaml.Node, string, error) {
+	tokens, err := pointer(path)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(tokens) == 0 {
+		return nil, "", nil
+	}
+
+	node := resolve(d.root)
+	for _, token := range tokens[:len(tokens)-1] {
+		switch {
+		case node == nil:
+			return nil, "", fmt.Errorf("%w: %q not found", ErrInvalidPatch, path)
+		case node.Kind == yaml.MappingNode:
+			i := find(node, token)
+			if i == -1 {
+				return nil, "", fmt.Errorf("%w: %q not found", ErrInvalidPatch, path)
+			}
+
+			node = resolve(node.Content[i])
+		case node.Kind == yaml.SequenceNode:
+			i, err := index(node, token, false)
+			if err != nil {
+				return nil, "", err
+			}
+
+			node = resolve(node.Content[i])
+		default:
+			return nil, "", fmt.Errorf("%w: %q not found", ErrInvalidPatch, path)
+		}
+	}
+
+	if node == nil || (node.Kind != yaml.MappingNode && node.Kind != yaml.SequenceNode) {
+		return nil, "", fmt.Errorf("%w: %q not found", ErrInvalidPatch, path)
+	}
+
+	return node, tokens[len(tokens)-1], nil
+}
+
+// locate returns the container holding the node at path and the index of
+// the node in its content. The root has no container.
+func (d *document) locate(path string) (*yaml.Node, int, error) {
+	container, token, err := d.parent(path)
+	if err != nil || container == nil {
+		return nil, 0, err
+	}
+
+	if container.Kind == yaml.MappingNode {
+		i := find(container, token)
+		if i == -1 {
+			return nil, 0, fmt.Errorf("%w: %q not found", ErrInvalidPatch, path)
+		}
+
+		return container, i, nil
+	}
+
+	i, err := index(container, token, false)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return container, i, nil
+}
+
+func (d *document) get(path string) (*yaml.Node, error) {
+	container, i, err := d.locate(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if container == nil {
+		return d.root, nil
+	}
+
+	return container.Content[i], nil
+}
+
+// replace swaps the node at path for value, which unlike add never inserts
+// into a sequence.
+func (d *document) replace(path string, value *yaml.Node) error {
+	container, i, err := d.locate(path)
+	if err != nil {
+		return err
+	}
+
+	if container == nil {
+		d.root = keep(d.root, value)
+		return nil
+	}
+
+	container.Content[i] = keep(container.Content[i], value)
+
+	return nil
+}
+
+func (d *document) add(path string, value *yaml.Node) error {
+	container, token, err := d.parent(path)
+	if err != nil {
+		return err
+	}
+
+	if container == nil {
+		d.root = keep(d.root, value)
+		return nil
+	}
+
+	if container.Kind == yaml.MappingNode {
+		if i := find(container, token); i != -1 {
+			container.Content[i] = keep(container.Content[i], value)
+		} else {
+			container.Content = append(container.Content,
+				&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: token}, value)
+		}
+
+		return nil
+	}
+
+	i, err := index(container, token, true)
+	if err != nil {
+		return err
+	}
+
+	container.Content = append(container.Content[:i], append([]*yaml.Node{value}, container.Content[i:]...)...)
+
+	return nil
+}
+
+func (d *document) remove(path string) (*yaml.Node, error) {
+	container, token, err := d.parent(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if container == nil {
+		return nil, fmt.Errorf("%w: cannot remove the root", ErrInvalidPatch)
+	}
+
+	if container.Kind == yaml.MappingNode {
+		i := find(container, token)
+		if i == -1 {
+			return nil, fmt.Errorf("%w: %q not found", ErrInvalidPatch, path)
+		}
+
+		removed := container.Content[i]
+		container.Content = append(container.Content[:i-1], container.Content[i+1:]...)
+
+		return removed, nil
+	}
+
+	i, err := index(container, token, false)
+	if err != nil {
+		return nil, err
+	}
+
+	removed := container.Content[i]
+	container.Content = append(container.Content[:i], container.Content[i+1:]...)
+
+	return removed, nil
+}
+
+// normalize brings decoded values to their JSON form, so that YAML integers
+// compare equal to JSON numbers.
+func normalize(value any) (any, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var result any
+	err = json.Unmarshal(data, &result)
+
+	return result, err
+}
+
+func (d *document) apply(op Operation) error {
+	switch op.Op {
+	case "add":
+		value, err := toNode(op.Value)
+		if err != nil {
+			return err
+		}
+
+		return d.add(op.Path, value)
+	case "remove":
+		_, err := d.remove(op.Path)
+
+		return err
+	case "replace":
+		value, err := toNode(op.Value)
+		if err != nil {
+			return err
+		}
+
+		return d.replace(op.Path, value)
+	case "move":
+		if op.Path != op.From && strings.HasPrefix(op.Path, op.From+"/") {
+			return fmt.Errorf("%w: cannot move %q into itself", ErrInvalidPatch, op.From)
+		}
+
+		value, err := d.remove(op.From)
+		if err != nil {
+			return err
+		}
+
+		return d.add(op.Path, value)
+	case "copy":
+		source, err := d.get(op.From)
+		if err != nil {
+			return err
+		}
+
+		decoded, err := fromNode(resolve(source))
+		if err != nil {
+			return err
+		}
+
+		value, err := toNode(decoded)
+		if err != nil {
+			return err
+		}
+
+		return d.add(op.Path, value)
+	case "test":
+		target, err := d.get(op.Path)
+		if err != nil {
+			return err
+		}
+
+		decoded, err := fromNode(resolve(target))
+		if err != nil {
+			return err
+		}
+
+		actual, err := normalize(decoded)
+		if err != nil {
+			return err
+		}
+
+		expected, err := normalize(op.Value)
+		if err != nil {
+			return err
+		}
+
+		if !reflect.DeepEqual(actual, expected) {
+			return fmt.Errorf("%w: %q does not hold the expected value", ErrTestFailed, op.Path)
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("%w: unknown operation %q", ErrInvalidPatch, op.Op)
+	}
+}
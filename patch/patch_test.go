@@ -0,0 +1,311 @@
+package patch
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/osamikoyo/yoconf/format"
+)
+
+func equalJSON(t *testing.T, got, want string) bool {
+	t.Helper()
+
+	var a, b any
+	if err := json.Unmarshal([]byte(got), &a); err != nil {
+		t.Fatalf("result %q: %v", got, err)
+	}
+	if err := json.Unmarshal([]byte(want), &b); err != nil {
+		t.Fatalf("expected %q: %v", want, err)
+	}
+
+	return reflect.DeepEqual(a, b)
+}
+
+// TestJSONPatch runs the examples of RFC 6902, Appendix A.
+func TestJSONPatch(t *testing.T) {
+	tests := []struct {
+		name  string
+		doc   string
+		patch string
+		want  string
+		err   error
+	}{
+		{
+			name:  "A.1 add object member",
+			doc:   `{"foo":"bar"}`,
+			patch: `[{"op":"add","path":"/baz","value":"qux"}]`,
+			want:  `{"baz":"qux","foo":"bar"}`,
+		},
+		{
+			name:  "A.2 add array element",
+			doc:   `{"foo":["bar","baz"]}`,
+			patch: `[{"op":"add","path":"/foo/1","value":"qux"}]`,
+			want:  `{"foo":["bar","qux","baz"]}`,
+		},
+		{
+			name:  "A.3 remove object member",
+			doc:   `{"baz":"qux","foo":"bar"}`,
+			patch: `[{"op":"remove","path":"/baz"}]`,
+			want:  `{"foo":"bar"}`,
+		},
+		{
+			name:  "A.4 remove array element",
+			doc:   `{"foo":["bar","qux","baz"]}`,
+			patch: `[{"op":"remove","path":"/foo/1"}]`,
+			want:  `{"foo":["bar","baz"]}`,
+		},
+		{
+			name:  "A.5 replace value",
+			doc:   `{"baz":"qux","foo":"bar"}`,
+			patch: `[{"op":"replace","path":"/baz","value":"boo"}]`,
+			want:  `{"baz":"boo","foo":"bar"}`,
+		},
+		{
+			name:  "A.6 move value",
+			doc:   `{"foo":{"bar":"baz","waldo":"fred"},"qux":{"corge":"grault"}}`,
+			patch: `[{"op":"move","from":"/foo/waldo","path":"/qux/thud"}]`,
+			want:  `{"foo":{"bar":"baz"},"qux":{"corge":"grault","thud":"fred"}}`,
+		},
+		{
+			name:  "A.7 move array element",
+			doc:   `{"foo":["all","grass","cows","eat"]}`,
+			patch: `[{"op":"move","from":"/foo/1","path":"/foo/3"}]`,
+			want:  `{"foo":["all","cows","eat","grass"]}`,
+		},
+		{
+			name: "A.8 test success",
+			doc:  `{"baz":"qux","foo":["a",2,"c"]}`,
+			patch: `[{"op":"test","path":"/baz","value":"qux"},
+				{"op":"test","path":"/foo/1","value":2}]`,
+			want: `{"baz":"qux","foo":["a",2,"c"]}`,
+		},
+		{
+			name:  "A.9 test error",
+			doc:   `{"baz":"qux"}`,
+			patch: `[{"op":"test","path":"/baz","value":"bar"}]`,
+			err:   ErrTestFailed,
+		},
+		{
+			name:  "A.10 add nested member object",
+			doc:   `{"foo":"bar"}`,
+			patch: `[{"op":"add","path":"/child","value":{"grandchild":{}}}]`,
+			want:  `{"foo":"bar","child":{"grandchild":{}}}`,
+		},
+		{
+			name:  "A.11 ignore unrecognized elements",
+			doc:   `{"foo":"bar"}`,
+			patch: `[{"op":"add","path":"/baz","value":"qux","xyz":123}]`,
+			want:  `{"foo":"bar","baz":"qux"}`,
+		},
+		{
+			name:  "A.12 add to nonexistent target",
+			doc:   `{"foo":"bar"}`,
+			patch: `[{"op":"add","path":"/baz/bat","value":"qux"}]`,
+			err:   ErrInvalidPatch,
+		},
+		{
+			name: "A.14 ~ escape ordering",
+			doc:  `{"/":9,"~1":10}`,
+			patch: `[{"op":"test","path":"/~01","value":10},
+				{"op":"test","path":"/~1","value":9}]`,
+			want: `{"/":9,"~1":10}`,
+		},
+		{
+			name:  "A.15 comparing strings and numbers",
+			doc:   `{"/":9,"~1":10}`,
+			patch: `[{"op":"test","path":"/~01","value":"10"}]`,
+			err:   ErrTestFailed,
+		},
+		{
+			name:  "A.16 add array value",
+			doc:   `{"foo":["bar"]}`,
+			patch: `[{"op":"add","path":"/foo/-","value":["abc","def"]}]`,
+			want:  `{"foo":["bar",["abc","def"]]}`,
+		},
+		{
+			name:  "replace array element",
+			doc:   `{"list":["a","b"]}`,
+			patch: `[{"op":"replace","path":"/list/0","value":"X"}]`,
+			want:  `{"list":["X","b"]}`,
+		},
+		{
+			name:  "replace last array element",
+			doc:   `{"list":["a","b"]}`,
+			patch: `[{"op":"replace","path":"/list/1","value":"X"}]`,
+			want:  `{"list":["a","X"]}`,
+		},
+		{
+			name:  "replace past the end",
+			doc:   `{"list":["a","b"]}`,
+			patch: `[{"op":"replace","path":"/list/2","value":"X"}]`,
+			err:   ErrInvalidPatch,
+		},
+		{
+			name:  "replace missing member",
+			doc:   `{"foo":"bar"}`,
+			patch: `[{"op":"replace","path":"/baz","value":"X"}]`,
+			err:   ErrInvalidPatch,
+		},
+		{
+			name:  "copy",
+			doc:   `{"a":{"b":1}}`,
+			patch: `[{"op":"copy","from":"/a","path":"/c"},{"op":"replace","path":"/c/b","value":2}]`,
+			want:  `{"a":{"b":1},"c":{"b":2}}`,
+		},
+		{
+			name:  "move into itself",
+			doc:   `{"a":{"b":1}}`,
+			patch: `[{"op":"move","from":"/a","path":"/a/b"}]`,
+			err:   ErrInvalidPatch,
+		},
+		{
+			name:  "leading zero index",
+			doc:   `{"foo":["a","b"]}`,
+			patch: `[{"op":"remove","path":"/foo/01"}]`,
+			err:   ErrInvalidPatch,
+		},
+		{
+			name:  "unknown operation",
+			doc:   `{}`,
+			patch: `[{"op":"frob","path":"/a"}]`,
+			err:   ErrInvalidPatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Apply(JSONPatch, format.JSON, tt.doc, []byte(tt.patch))
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("Apply() error = %v, want %v", err, tt.err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Apply() error = %v", err)
+			}
+
+			if !equalJSON(t, got, tt.want) {
+				t.Errorf("Apply() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestMergePatch runs the examples of RFC 7396, Appendix A.
+func TestMergePatch(t *testing.T) {
+	tests := []struct {
+		doc   string
+		patch string
+		want  string
+	}{
+		{`{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{`{"a":"b"}`, `{"a":null}`, `{}`},
+		{`{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{`{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"c"}`, `{"a":["b"]}`, `{"a":["b"]}`},
+		{`{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+		{`{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+		{`["a","b"]`, `["c","d"]`, `["c","d"]`},
+		{`{"a":"b"}`, `["c"]`, `["c"]`},
+		{`{"a":"foo"}`, `null`, `null`},
+		{`{"a":"foo"}`, `"bar"`, `"bar"`},
+		{`{"e":null}`, `{"a":1}`, `{"e":null,"a":1}`},
+		{`[1,2]`, `{"a":"b","c":null}`, `{"a":"b"}`},
+		{`{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`},
+	}
+
+	for _, tt := range tests {
+		got, err := Apply(MergePatch, format.JSON, tt.doc, []byte(tt.patch))
+		if err != nil {
+			t.Errorf("%s + %s: %v", tt.doc, tt.patch, err)
+			continue
+		}
+
+		if !equalJSON(t, got, tt.want) {
+			t.Errorf("%s + %s = %s, want %s", tt.doc, tt.patch, got, tt.want)
+		}
+	}
+}
+
+// TestMergePatchExample runs the example of RFC 7396, Section 3.
+func TestMergePatchExample(t *testing.T) {
+	doc := `{
+		"title": "Goodbye!",
+		"author": {"givenName": "John", "familyName": "Doe"},
+		"tags": ["example", "sample"],
+		"content": "This will be unchanged"
+	}`
+	body := `{
+		"title": "Hello!",
+		"phoneNumber": "+01-123-456-7890",
+		"author": {"familyName": null},
+		"tags": ["example"]
+	}`
+	want := `{
+		"title": "Hello!",
+		"author": {"givenName": "John"},
+		"tags": ["example"],
+		"content": "This will be unchanged",
+		"phoneNumber": "+01-123-456-7890"
+	}`
+
+	got, err := Apply(MergePatch, format.JSON, doc, []byte(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !equalJSON(t, got, want) {
+		t.Errorf("Apply() = %s, want %s", got, want)
+	}
+}
+
+func TestApplyKeepsYAML(t *testing.T) {
+	doc := "# service settings\nname: api # the name\nports:\n  - 80\n  - 443\nlimits:\n  rps: 10\n"
+	body := `[{"op":"replace","path":"/ports/0","value":8080},{"op":"replace","path":"/name","value":"web"}]`
+	want := "# service settings\nname: web # the name\nports:\n  - 8080\n  - 443\nlimits:\n  rps: 10\n"
+
+	got, err := Apply(JSONPatch, format.YAML, doc, []byte(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got != want {
+		t.Errorf("Apply() =\n%s\nwant\n%s", got, want)
+	}
+
+	got, err = Apply(MergePatch, format.YAML, doc, []byte(`{"limits":{"burst":20}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasPrefix(got, "# service settings\nname: api # the name\n") || !strings.HasSuffix(got, "  rps: 10\n  burst: 20\n") {
+		t.Errorf("Apply() =\n%s", got)
+	}
+}
+
+func TestKind(t *testing.T) {
+	tests := map[string]string{
+		"":                                MergePatch,
+		"application/json-patch+json":     JSONPatch,
+		"application/merge-patch+json":    MergePatch,
+		"application/json; charset=utf-8": MergePatch,
+		JSONPatch:                         JSONPatch,
+	}
+
+	for name, want := range tests {
+		if got, err := Kind(name); err != nil || got != want {
+			t.Errorf("Kind(%q) = %q, %v, want %q", name, got, err, want)
+		}
+	}
+
+	if _, err := Kind("text/plain"); !errors.Is(err, ErrInvalidPatch) {
+		t.Errorf("Kind(text/plain) error = %v, want %v", err, ErrInvalidPatch)
+	}
+}
@@ -0,0 +1,1130 @@
+// Wire-compatible subset of the flagd evaluation protocol, so OpenFeature
+// flagd providers can resolve flags stored in yoconf flag projects.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        v6.30.2
+// source: proto/flagd/evaluation.proto
+
+package flagd
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ResolveAllRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *structpb.Struct       `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveAllRequest) Reset() {
+	*x = ResolveAllRequest{}
+	mi := &file_proto_flagd_evaluation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveAllRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveAllRequest) ProtoMessage() {}
+
+func (x *ResolveAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_flagd_evaluation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveAllRequest.ProtoReflect.Descriptor instead.
+func (*ResolveAllRequest) Descriptor() ([]byte, []int) {
+	return file_proto_flagd_evaluation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ResolveAllRequest) GetContext() *structpb.Struct {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type ResolveAllResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flags         map[string]*AnyFlag    `protobuf:"bytes,1,rep,name=flags,proto3" json:"flags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Metadata      *structpb.Struct       `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveAllResponse) Reset() {
+	*x = ResolveAllResponse{}
+	mi := &file_proto_flagd_evaluation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveAllResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveAllResponse) ProtoMessage() {}
+
+func (x *ResolveAllResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_flagd_evaluation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveAllResponse.ProtoReflect.Descriptor instead.
+func (*ResolveAllResponse) Descriptor() ([]byte, []int) {
+	return file_proto_flagd_evaluation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ResolveAllResponse) GetFlags() map[string]*AnyFlag {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *ResolveAllResponse) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type AnyFlag struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Reason  string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	Variant string                 `protobuf:"bytes,2,opt,name=variant,proto3" json:"variant,omitempty"`
+	// Types that are valid to be assigned to Value:
+	//
+	//	*AnyFlag_BoolValue
+	//	*AnyFlag_StringValue
+	//	*AnyFlag_DoubleValue
+	//	*AnyFlag_ObjectValue
+	Value         isAnyFlag_Value  `protobuf_oneof:"value"`
+	Metadata      *structpb.Struct `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnyFlag) Reset() {
+	*x = AnyFlag{}
+	mi := &file_proto_flagd_evaluation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnyFlag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnyFlag) ProtoMessage() {}
+
+func (x *AnyFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_flagd_evaluation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnyFlag.ProtoReflect.Descriptor instead.
+func (*AnyFlag) Descriptor() ([]byte, []int) {
+	return file_proto_flagd_evaluation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AnyFlag) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AnyFlag) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
+func (x *AnyFlag) GetValue() isAnyFlag_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *AnyFlag) GetBoolValue() bool {
+	if x != nil {
+		if x, ok := x.Value.(*AnyFlag_BoolValue); ok {
+			return x.BoolValue
+		}
+	}
+	return false
+}
+
+func (x *AnyFlag) GetStringValue() string {
+	if x != nil {
+		if x, ok := x.Value.(*AnyFlag_StringValue); ok {
+			return x.StringValue
+		}
+	}
+	return ""
+}
+
+func (x *AnyFlag) GetDoubleValue() float64 {
+	if x != nil {
+		if x, ok := x.Value.(*AnyFlag_DoubleValue); ok {
+			return x.DoubleValue
+		}
+	}
+	return 0
+}
+
+func (x *AnyFlag) GetObjectValue() *structpb.Struct {
+	if x != nil {
+		if x, ok := x.Value.(*AnyFlag_ObjectValue); ok {
+			return x.ObjectValue
+		}
+	}
+	return nil
+}
+
+func (x *AnyFlag) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type isAnyFlag_Value interface {
+	isAnyFlag_Value()
+}
+
+type AnyFlag_BoolValue struct {
+	BoolValue bool `protobuf:"varint,3,opt,name=bool_value,json=boolValue,proto3,oneof"`
+}
+
+type AnyFlag_StringValue struct {
+	StringValue string `protobuf:"bytes,4,opt,name=string_value,json=stringValue,proto3,oneof"`
+}
+
+type AnyFlag_DoubleValue struct {
+	DoubleValue float64 `protobuf:"fixed64,5,opt,name=double_value,json=doubleValue,proto3,oneof"`
+}
+
+type AnyFlag_ObjectValue struct {
+	ObjectValue *structpb.Struct `protobuf:"bytes,6,opt,name=object_value,json=objectValue,proto3,oneof"`
+}
+
+func (*AnyFlag_BoolValue) isAnyFlag_Value() {}
+
+func (*AnyFlag_StringValue) isAnyFlag_Value() {}
+
+func (*AnyFlag_DoubleValue) isAnyFlag_Value() {}
+
+func (*AnyFlag_ObjectValue) isAnyFlag_Value() {}
+
+type ResolveBooleanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FlagKey       string                 `protobuf:"bytes,1,opt,name=flag_key,json=flagKey,proto3" json:"flag_key,omitempty"`
+	Context       *structpb.Struct       `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveBooleanRequest) Reset() {
+	*x = ResolveBooleanRequest{}
+	mi := &file_proto_flagd_evaluation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveBooleanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveBooleanRequest) ProtoMessage() {}
+
+func (x *ResolveBooleanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_flagd_evaluation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveBooleanRequest.ProtoReflect.Descriptor instead.
+func (*ResolveBooleanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_flagd_evaluation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ResolveBooleanRequest) GetFlagKey() string {
+	if x != nil {
+		return x.FlagKey
+	}
+	return ""
+}
+
+func (x *ResolveBooleanRequest) GetContext() *structpb.Struct {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type ResolveBooleanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         bool                   `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Variant       string                 `protobuf:"bytes,3,opt,name=variant,proto3" json:"variant,omitempty"`
+	Metadata      *structpb.Struct       `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveBooleanResponse) Reset() {
+	*x = ResolveBooleanResponse{}
+	mi := &file_proto_flagd_evaluation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveBooleanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveBooleanResponse) ProtoMessage() {}
+
+func (x *ResolveBooleanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_flagd_evaluation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveBooleanResponse.ProtoReflect.Descriptor instead.
+func (*ResolveBooleanResponse) Descriptor() ([]byte, []int) {
+	return file_proto_flagd_evaluation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ResolveBooleanResponse) GetValue() bool {
+	if x != nil {
+		return x.Value
+	}
+	return false
+}
+
+func (x *ResolveBooleanResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ResolveBooleanResponse) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
+func (x *ResolveBooleanResponse) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type ResolveStringRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FlagKey       string                 `protobuf:"bytes,1,opt,name=flag_key,json=flagKey,proto3" json:"flag_key,omitempty"`
+	Context       *structpb.Struct       `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveStringRequest) Reset() {
+	*x = ResolveStringRequest{}
+	mi := &file_proto_flagd_evaluation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveStringRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveStringRequest) ProtoMessage() {}
+
+func (x *ResolveStringRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_flagd_evaluation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveStringRequest.ProtoReflect.Descriptor instead.
+func (*ResolveStringRequest) Descriptor() ([]byte, []int) {
+	return file_proto_flagd_evaluation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ResolveStringRequest) GetFlagKey() string {
+	if x != nil {
+		return x.FlagKey
+	}
+	return ""
+}
+
+func (x *ResolveStringRequest) GetContext() *structpb.Struct {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type ResolveStringResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Variant       string                 `protobuf:"bytes,3,opt,name=variant,proto3" json:"variant,omitempty"`
+	Metadata      *structpb.Struct       `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveStringResponse) Reset() {
+	*x = ResolveStringResponse{}
+	mi := &file_proto_flagd_evaluation_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveStringResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveStringResponse) ProtoMessage() {}
+
+func (x *ResolveStringResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_flagd_evaluation_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveStringResponse.ProtoReflect.Descriptor instead.
+func (*ResolveStringResponse) Descriptor() ([]byte, []int) {
+	return file_proto_flagd_evaluation_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ResolveStringResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ResolveStringResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ResolveStringResponse) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
+func (x *ResolveStringResponse) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type ResolveFloatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FlagKey       string                 `protobuf:"bytes,1,opt,name=flag_key,json=flagKey,proto3" json:"flag_key,omitempty"`
+	Context       *structpb.Struct       `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveFloatRequest) Reset() {
+	*x = ResolveFloatRequest{}
+	mi := &file_proto_flagd_evaluation_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveFloatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveFloatRequest) ProtoMessage() {}
+
+func (x *ResolveFloatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_flagd_evaluation_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveFloatRequest.ProtoReflect.Descriptor instead.
+func (*ResolveFloatRequest) Descriptor() ([]byte, []int) {
+	return file_proto_flagd_evaluation_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ResolveFloatRequest) GetFlagKey() string {
+	if x != nil {
+		return x.FlagKey
+	}
+	return ""
+}
+
+func (x *ResolveFloatRequest) GetContext() *structpb.Struct {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type ResolveFloatResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         float64                `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Variant       string                 `protobuf:"bytes,3,opt,name=variant,proto3" json:"variant,omitempty"`
+	Metadata      *structpb.Struct       `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveFloatResponse) Reset() {
+	*x = ResolveFloatResponse{}
+	mi := &file_proto_flagd_evaluation_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveFloatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveFloatResponse) ProtoMessage() {}
+
+func (x *ResolveFloatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_flagd_evaluation_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveFloatResponse.ProtoReflect.Descriptor instead.
+func (*ResolveFloatResponse) Descriptor() ([]byte, []int) {
+	return file_proto_flagd_evaluation_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ResolveFloatResponse) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *ResolveFloatResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ResolveFloatResponse) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
+func (x *ResolveFloatResponse) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type ResolveIntRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FlagKey       string                 `protobuf:"bytes,1,opt,name=flag_key,json=flagKey,proto3" json:"flag_key,omitempty"`
+	Context       *structpb.Struct       `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveIntRequest) Reset() {
+	*x = ResolveIntRequest{}
+	mi := &file_proto_flagd_evaluation_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveIntRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveIntRequest) ProtoMessage() {}
+
+func (x *ResolveIntRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_flagd_evaluation_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveIntRequest.ProtoReflect.Descriptor instead.
+func (*ResolveIntRequest) Descriptor() ([]byte, []int) {
+	return file_proto_flagd_evaluation_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ResolveIntRequest) GetFlagKey() string {
+	if x != nil {
+		return x.FlagKey
+	}
+	return ""
+}
+
+func (x *ResolveIntRequest) GetContext() *structpb.Struct {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type ResolveIntResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         int64                  `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Variant       string                 `protobuf:"bytes,3,opt,name=variant,proto3" json:"variant,omitempty"`
+	Metadata      *structpb.Struct       `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveIntResponse) Reset() {
+	*x = ResolveIntResponse{}
+	mi := &file_proto_flagd_evaluation_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveIntResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveIntResponse) ProtoMessage() {}
+
+func (x *ResolveIntResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_flagd_evaluation_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveIntResponse.ProtoReflect.Descriptor instead.
+func (*ResolveIntResponse) Descriptor() ([]byte, []int) {
+	return file_proto_flagd_evaluation_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ResolveIntResponse) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *ResolveIntResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ResolveIntResponse) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
+func (x *ResolveIntResponse) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type ResolveObjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FlagKey       string                 `protobuf:"bytes,1,opt,name=flag_key,json=flagKey,proto3" json:"flag_key,omitempty"`
+	Context       *structpb.Struct       `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveObjectRequest) Reset() {
+	*x = ResolveObjectRequest{}
+	mi := &file_proto_flagd_evaluation_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveObjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveObjectRequest) ProtoMessage() {}
+
+func (x *ResolveObjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_flagd_evaluation_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveObjectRequest.ProtoReflect.Descriptor instead.
+func (*ResolveObjectRequest) Descriptor() ([]byte, []int) {
+	return file_proto_flagd_evaluation_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ResolveObjectRequest) GetFlagKey() string {
+	if x != nil {
+		return x.FlagKey
+	}
+	return ""
+}
+
+func (x *ResolveObjectRequest) GetContext() *structpb.Struct {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type ResolveObjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         *structpb.Struct       `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Variant       string                 `protobuf:"bytes,3,opt,name=variant,proto3" json:"variant,omitempty"`
+	Metadata      *structpb.Struct       `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveObjectResponse) Reset() {
+	*x = ResolveObjectResponse{}
+	mi := &file_proto_flagd_evaluation_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveObjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveObjectResponse) ProtoMessage() {}
+
+func (x *ResolveObjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_flagd_evaluation_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveObjectResponse.ProtoReflect.Descriptor instead.
+func (*ResolveObjectResponse) Descriptor() ([]byte, []int) {
+	return file_proto_flagd_evaluation_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ResolveObjectResponse) GetValue() *structpb.Struct {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *ResolveObjectResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ResolveObjectResponse) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
+func (x *ResolveObjectResponse) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type EventStreamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventStreamRequest) Reset() {
+	*x = EventStreamRequest{}
+	mi := &file_proto_flagd_evaluation_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventStreamRequest) ProtoMessage() {}
+
+func (x *EventStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_flagd_evaluation_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventStreamRequest.ProtoReflect.Descriptor instead.
+func (*EventStreamRequest) Descriptor() ([]byte, []int) {
+	return file_proto_flagd_evaluation_proto_rawDescGZIP(), []int{13}
+}
+
+type EventStreamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Data          *structpb.Struct       `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventStreamResponse) Reset() {
+	*x = EventStreamResponse{}
+	mi := &file_proto_flagd_evaluation_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventStreamResponse) ProtoMessage() {}
+
+func (x *EventStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_flagd_evaluation_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventStreamResponse.ProtoReflect.Descriptor instead.
+func (*EventStreamResponse) Descriptor() ([]byte, []int) {
+	return file_proto_flagd_evaluation_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *EventStreamResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *EventStreamResponse) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_proto_flagd_evaluation_proto protoreflect.FileDescriptor
+
+const file_proto_flagd_evaluation_proto_rawDesc = "" +
+	"\n" +
+	"\x1cproto/flagd/evaluation.proto\x12\x13flagd.evaluation.v1\x1a\x1cgoogle/protobuf/struct.proto\"F\n" +
+	"\x11ResolveAllRequest\x121\n" +
+	"\acontext\x18\x01 \x01(\v2\x17.google.protobuf.StructR\acontext\"\xeb\x01\n" +
+	"\x12ResolveAllResponse\x12H\n" +
+	"\x05flags\x18\x01 \x03(\v22.flagd.evaluation.v1.ResolveAllResponse.FlagsEntryR\x05flags\x123\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x17.google.protobuf.StructR\bmetadata\x1aV\n" +
+	"\n" +
+	"FlagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x122\n" +
+	"\x05value\x18\x02 \x01(\v2\x1c.flagd.evaluation.v1.AnyFlagR\x05value:\x028\x01\"\xa2\x02\n" +
+	"\aAnyFlag\x12\x16\n" +
+	"\x06reason\x18\x01 \x01(\tR\x06reason\x12\x18\n" +
+	"\avariant\x18\x02 \x01(\tR\avariant\x12\x1f\n" +
+	"\n" +
+	"bool_value\x18\x03 \x01(\bH\x00R\tboolValue\x12#\n" +
+	"\fstring_value\x18\x04 \x01(\tH\x00R\vstringValue\x12#\n" +
+	"\fdouble_value\x18\x05 \x01(\x01H\x00R\vdoubleValue\x12<\n" +
+	"\fobject_value\x18\x06 \x01(\v2\x17.google.protobuf.StructH\x00R\vobjectValue\x123\n" +
+	"\bmetadata\x18\a \x01(\v2\x17.google.protobuf.StructR\bmetadataB\a\n" +
+	"\x05value\"e\n" +
+	"\x15ResolveBooleanRequest\x12\x19\n" +
+	"\bflag_key\x18\x01 \x01(\tR\aflagKey\x121\n" +
+	"\acontext\x18\x02 \x01(\v2\x17.google.protobuf.StructR\acontext\"\x95\x01\n" +
+	"\x16ResolveBooleanResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\bR\x05value\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x18\n" +
+	"\avariant\x18\x03 \x01(\tR\avariant\x123\n" +
+	"\bmetadata\x18\x04 \x01(\v2\x17.google.protobuf.StructR\bmetadata\"d\n" +
+	"\x14ResolveStringRequest\x12\x19\n" +
+	"\bflag_key\x18\x01 \x01(\tR\aflagKey\x121\n" +
+	"\acontext\x18\x02 \x01(\v2\x17.google.protobuf.StructR\acontext\"\x94\x01\n" +
+	"\x15ResolveStringResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x18\n" +
+	"\avariant\x18\x03 \x01(\tR\avariant\x123\n" +
+	"\bmetadata\x18\x04 \x01(\v2\x17.google.protobuf.StructR\bmetadata\"c\n" +
+	"\x13ResolveFloatRequest\x12\x19\n" +
+	"\bflag_key\x18\x01 \x01(\tR\aflagKey\x121\n" +
+	"\acontext\x18\x02 \x01(\v2\x17.google.protobuf.StructR\acontext\"\x93\x01\n" +
+	"\x14ResolveFloatResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x01R\x05value\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x18\n" +
+	"\avariant\x18\x03 \x01(\tR\avariant\x123\n" +
+	"\bmetadata\x18\x04 \x01(\v2\x17.google.protobuf.StructR\bmetadata\"a\n" +
+	"\x11ResolveIntRequest\x12\x19\n" +
+	"\bflag_key\x18\x01 \x01(\tR\aflagKey\x121\n" +
+	"\acontext\x18\x02 \x01(\v2\x17.google.protobuf.StructR\acontext\"\x91\x01\n" +
+	"\x12ResolveIntResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x03R\x05value\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x18\n" +
+	"\avariant\x18\x03 \x01(\tR\avariant\x123\n" +
+	"\bmetadata\x18\x04 \x01(\v2\x17.google.protobuf.StructR\bmetadata\"d\n" +
+	"\x14ResolveObjectRequest\x12\x19\n" +
+	"\bflag_key\x18\x01 \x01(\tR\aflagKey\x121\n" +
+	"\acontext\x18\x02 \x01(\v2\x17.google.protobuf.StructR\acontext\"\xad\x01\n" +
+	"\x15ResolveObjectResponse\x12-\n" +
+	"\x05value\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x05value\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x18\n" +
+	"\avariant\x18\x03 \x01(\tR\avariant\x123\n" +
+	"\bmetadata\x18\x04 \x01(\v2\x17.google.protobuf.StructR\bmetadata\"\x14\n" +
+	"\x12EventStreamRequest\"V\n" +
+	"\x13EventStreamResponse\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12+\n" +
+	"\x04data\x18\x02 \x01(\v2\x17.google.protobuf.StructR\x04data2\xd9\x05\n" +
+	"\aService\x12_\n" +
+	"\n" +
+	"ResolveAll\x12&.flagd.evaluation.v1.ResolveAllRequest\x1a'.flagd.evaluation.v1.ResolveAllResponse\"\x00\x12k\n" +
+	"\x0eResolveBoolean\x12*.flagd.evaluation.v1.ResolveBooleanRequest\x1a+.flagd.evaluation.v1.ResolveBooleanResponse\"\x00\x12h\n" +
+	"\rResolveString\x12).flagd.evaluation.v1.ResolveStringRequest\x1a*.flagd.evaluation.v1.ResolveStringResponse\"\x00\x12e\n" +
+	"\fResolveFloat\x12(.flagd.evaluation.v1.ResolveFloatRequest\x1a).flagd.evaluation.v1.ResolveFloatResponse\"\x00\x12_\n" +
+	"\n" +
+	"ResolveInt\x12&.flagd.evaluation.v1.ResolveIntRequest\x1a'.flagd.evaluation.v1.ResolveIntResponse\"\x00\x12h\n" +
+	"\rResolveObject\x12).flagd.evaluation.v1.ResolveObjectRequest\x1a*.flagd.evaluation.v1.ResolveObjectResponse\"\x00\x12d\n" +
+	"\vEventStream\x12'.flagd.evaluation.v1.EventStreamRequest\x1a(.flagd.evaluation.v1.EventStreamResponse\"\x000\x01B\fZ\n" +
+	"./pb/flagdb\x06proto3"
+
+var (
+	file_proto_flagd_evaluation_proto_rawDescOnce sync.Once
+	file_proto_flagd_evaluation_proto_rawDescData []byte
+)
+
+func file_proto_flagd_evaluation_proto_rawDescGZIP() []byte {
+	file_proto_flagd_evaluation_proto_rawDescOnce.Do(func() {
+		file_proto_flagd_evaluation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_flagd_evaluation_proto_rawDesc), len(file_proto_flagd_evaluation_proto_rawDesc)))
+	})
+	return file_proto_flagd_evaluation_proto_rawDescData
+}
+
+var file_proto_flagd_evaluation_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_proto_flagd_evaluation_proto_goTypes = []any{
+	(*ResolveAllRequest)(nil),      // 0: flagd.evaluation.v1.ResolveAllRequest
+	(*ResolveAllResponse)(nil),     // 1: flagd.evaluation.v1.ResolveAllResponse
+	(*AnyFlag)(nil),                // 2: flagd.evaluation.v1.AnyFlag
+	(*ResolveBooleanRequest)(nil),  // 3: flagd.evaluation.v1.ResolveBooleanRequest
+	(*ResolveBooleanResponse)(nil), // 4: flagd.evaluation.v1.ResolveBooleanResponse
+	(*ResolveStringRequest)(nil),   // 5: flagd.evaluation.v1.ResolveStringRequest
+	(*ResolveStringResponse)(nil),  // 6: flagd.evaluation.v1.ResolveStringResponse
+	(*ResolveFloatRequest)(nil),    // 7: flagd.evaluation.v1.ResolveFloatRequest
+	(*ResolveFloatResponse)(nil),   // 8: flagd.evaluation.v1.ResolveFloatResponse
+	(*ResolveIntRequest)(nil),      // 9: flagd.evaluation.v1.ResolveIntRequest
+	(*ResolveIntResponse)(nil),     // 10: flagd.evaluation.v1.ResolveIntResponse
+	(*ResolveObjectRequest)(nil),   // 11: flagd.evaluation.v1.ResolveObjectRequest
+	(*ResolveObjectResponse)(nil),  // 12: flagd.evaluation.v1.ResolveObjectResponse
+	(*EventStreamRequest)(nil),     // 13: flagd.evaluation.v1.EventStreamRequest
+	(*EventStreamResponse)(nil),    // 14: flagd.evaluation.v1.EventStreamResponse
+	nil,                            // 15: flagd.evaluation.v1.ResolveAllResponse.FlagsEntry
+	(*structpb.Struct)(nil),        // 16: google.protobuf.Struct
+}
+var file_proto_flagd_evaluation_proto_depIdxs = []int32{
+	16, // 0: flagd.evaluation.v1.ResolveAllRequest.context:type_name -> google.protobuf.Struct
+	15, // 1: flagd.evaluation.v1.ResolveAllResponse.flags:type_name -> flagd.evaluation.v1.ResolveAllResponse.FlagsEntry
+	16, // 2: flagd.evaluation.v1.ResolveAllResponse.metadata:type_name -> google.protobuf.Struct
+	16, // 3: flagd.evaluation.v1.AnyFlag.object_value:type_name -> google.protobuf.Struct
+	16, // 4: flagd.evaluation.v1.AnyFlag.metadata:type_name -> google.protobuf.Struct
+	16, // 5: flagd.evaluation.v1.ResolveBooleanRequest.context:type_name -> google.protobuf.Struct
+	16, // 6: flagd.evaluation.v1.ResolveBooleanResponse.metadata:type_name -> google.protobuf.Struct
+	16, // 7: flagd.evaluation.v1.ResolveStringRequest.context:type_name -> google.protobuf.Struct
+	16, // 8: flagd.evaluation.v1.ResolveStringResponse.metadata:type_name -> google.protobuf.Struct
+	16, // 9: flagd.evaluation.v1.ResolveFloatRequest.context:type_name -> google.protobuf.Struct
+	16, // 10: flagd.evaluation.v1.ResolveFloatResponse.metadata:type_name -> google.protobuf.Struct
+	16, // 11: flagd.evaluation.v1.ResolveIntRequest.context:type_name -> google.protobuf.Struct
+	16, // 12: flagd.evaluation.v1.ResolveIntResponse.metadata:type_name -> google.protobuf.Struct
+	16, // 13: flagd.evaluation.v1.ResolveObjectRequest.context:type_name -> google.protobuf.Struct
+	16, // 14: flagd.evaluation.v1.ResolveObjectResponse.value:type_name -> google.protobuf.Struct
+	16, // 15: flagd.evaluation.v1.ResolveObjectResponse.metadata:type_name -> google.protobuf.Struct
+	16, // 16: flagd.evaluation.v1.EventStreamResponse.data:type_name -> google.protobuf.Struct
+	2,  // 17: flagd.evaluation.v1.ResolveAllResponse.FlagsEntry.value:type_name -> flagd.evaluation.v1.AnyFlag
+	0,  // 18: flagd.evaluation.v1.Service.ResolveAll:input_type -> flagd.evaluation.v1.ResolveAllRequest
+	3,  // 19: flagd.evaluation.v1.Service.ResolveBoolean:input_type -> flagd.evaluation.v1.ResolveBooleanRequest
+	5,  // 20: flagd.evaluation.v1.Service.ResolveString:input_type -> flagd.evaluation.v1.ResolveStringRequest
+	7,  // 21: flagd.evaluation.v1.Service.ResolveFloat:input_type -> flagd.evaluation.v1.ResolveFloatRequest
+	9,  // 22: flagd.evaluation.v1.Service.ResolveInt:input_type -> flagd.evaluation.v1.ResolveIntRequest
+	11, // 23: flagd.evaluation.v1.Service.ResolveObject:input_type -> flagd.evaluation.v1.ResolveObjectRequest
+	13, // 24: flagd.evaluation.v1.Service.EventStream:input_type -> flagd.evaluation.v1.EventStreamRequest
+	1,  // 25: flagd.evaluation.v1.Service.ResolveAll:output_type -> flagd.evaluation.v1.ResolveAllResponse
+	4,  // 26: flagd.evaluation.v1.Service.ResolveBoolean:output_type -> flagd.evaluation.v1.ResolveBooleanResponse
+	6,  // 27: flagd.evaluation.v1.Service.ResolveString:output_type -> flagd.evaluation.v1.ResolveStringResponse
+	8,  // 28: flagd.evaluation.v1.Service.ResolveFloat:output_type -> flagd.evaluation.v1.ResolveFloatResponse
+	10, // 29: flagd.evaluation.v1.Service.ResolveInt:output_type -> flagd.evaluation.v1.ResolveIntResponse
+	12, // 30: flagd.evaluation.v1.Service.ResolveObject:output_type -> flagd.evaluation.v1.ResolveObjectResponse
+	14, // 31: flagd.evaluation.v1.Service.EventStream:output_type -> flagd.evaluation.v1.EventStreamResponse
+	25, // [25:32] is the sub-list for method output_type
+	18, // [18:25] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
+}
+
+func init() { file_proto_flagd_evaluation_proto_init() }
+func file_proto_flagd_evaluation_proto_init() {
+	if File_proto_flagd_evaluation_proto != nil {
+		return
+	}
+	file_proto_flagd_evaluation_proto_msgTypes[2].OneofWrappers = []any{
+		(*AnyFlag_BoolValue)(nil),
+		(*AnyFlag_StringValue)(nil),
+		(*AnyFlag_DoubleValue)(nil),
+		(*AnyFlag_ObjectValue)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_flagd_evaluation_proto_rawDesc), len(file_proto_flagd_evaluation_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_flagd_evaluation_proto_goTypes,
+		DependencyIndexes: file_proto_flagd_evaluation_proto_depIdxs,
+		MessageInfos:      file_proto_flagd_evaluation_proto_msgTypes,
+	}.Build()
+	File_proto_flagd_evaluation_proto = out.File
+	file_proto_flagd_evaluation_proto_goTypes = nil
+	file_proto_flagd_evaluation_proto_depIdxs = nil
+}
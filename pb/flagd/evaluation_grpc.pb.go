@@ -0,0 +1,356 @@
+// Wire-compatible subset of the flagd evaluation protocol, so OpenFeature
+// flagd providers can resolve flags stored in yoconf flag projects.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.30.2
+// source: proto/flagd/evaluation.proto
+
+package flagd
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Service_ResolveAll_FullMethodName     = "/flagd.evaluation.v1.Service/ResolveAll"
+	Service_ResolveBoolean_FullMethodName = "/flagd.evaluation.v1.Service/ResolveBoolean"
+	Service_ResolveString_FullMethodName  = "/flagd.evaluation.v1.Service/ResolveString"
+	Service_ResolveFloat_FullMethodName   = "/flagd.evaluation.v1.Service/ResolveFloat"
+	Service_ResolveInt_FullMethodName     = "/flagd.evaluation.v1.Service/ResolveInt"
+	Service_ResolveObject_FullMethodName  = "/flagd.evaluation.v1.Service/ResolveObject"
+	Service_EventStream_FullMethodName    = "/flagd.evaluation.v1.Service/EventStream"
+)
+
+// ServiceClient is the client API for Service service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ServiceClient interface {
+	ResolveAll(ctx context.Context, in *ResolveAllRequest, opts ...grpc.CallOption) (*ResolveAllResponse, error)
+	ResolveBoolean(ctx context.Context, in *ResolveBooleanRequest, opts ...grpc.CallOption) (*ResolveBooleanResponse, error)
+	ResolveString(ctx context.Context, in *ResolveStringRequest, opts ...grpc.CallOption) (*ResolveStringResponse, error)
+	ResolveFloat(ctx context.Context, in *ResolveFloatRequest, opts ...grpc.CallOption) (*ResolveFloatResponse, error)
+	ResolveInt(ctx context.Context, in *ResolveIntRequest, opts ...grpc.CallOption) (*ResolveIntResponse, error)
+	ResolveObject(ctx context.Context, in *ResolveObjectRequest, opts ...grpc.CallOption) (*ResolveObjectResponse, error)
+	EventStream(ctx context.Context, in *EventStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[EventStreamResponse], error)
+}
+
+type serviceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewServiceClient(cc grpc.ClientConnInterface) ServiceClient {
+	return &serviceClient{cc}
+}
+
+func (c *serviceClient) ResolveAll(ctx context.Context, in *ResolveAllRequest, opts ...grpc.CallOption) (*ResolveAllResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveAllResponse)
+	err := c.cc.Invoke(ctx, Service_ResolveAll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ResolveBoolean(ctx context.Context, in *ResolveBooleanRequest, opts ...grpc.CallOption) (*ResolveBooleanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveBooleanResponse)
+	err := c.cc.Invoke(ctx, Service_ResolveBoolean_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ResolveString(ctx context.Context, in *ResolveStringRequest, opts ...grpc.CallOption) (*ResolveStringResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveStringResponse)
+	err := c.cc.Invoke(ctx, Service_ResolveString_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ResolveFloat(ctx context.Context, in *ResolveFloatRequest, opts ...grpc.CallOption) (*ResolveFloatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveFloatResponse)
+	err := c.cc.Invoke(ctx, Service_ResolveFloat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ResolveInt(ctx context.Context, in *ResolveIntRequest, opts ...grpc.CallOption) (*ResolveIntResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveIntResponse)
+	err := c.cc.Invoke(ctx, Service_ResolveInt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ResolveObject(ctx context.Context, in *ResolveObjectRequest, opts ...grpc.CallOption) (*ResolveObjectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveObjectResponse)
+	err := c.cc.Invoke(ctx, Service_ResolveObject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) EventStream(ctx context.Context, in *EventStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[EventStreamResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Service_ServiceDesc.Streams[0], Service_EventStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[EventStreamRequest, EventStreamResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Service_EventStreamClient = grpc.ServerStreamingClient[EventStreamResponse]
+
+// ServiceServer is the server API for Service service.
+// All implementations must embed UnimplementedServiceServer
+// for forward compatibility.
+type ServiceServer interface {
+	ResolveAll(context.Context, *ResolveAllRequest) (*ResolveAllResponse, error)
+	ResolveBoolean(context.Context, *ResolveBooleanRequest) (*ResolveBooleanResponse, error)
+	ResolveString(context.Context, *ResolveStringRequest) (*ResolveStringResponse, error)
+	ResolveFloat(context.Context, *ResolveFloatRequest) (*ResolveFloatResponse, error)
+	ResolveInt(context.Context, *ResolveIntRequest) (*ResolveIntResponse, error)
+	ResolveObject(context.Context, *ResolveObjectRequest) (*ResolveObjectResponse, error)
+	EventStream(*EventStreamRequest, grpc.ServerStreamingServer[EventStreamResponse]) error
+	mustEmbedUnimplementedServiceServer()
+}
+
+// UnimplementedServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedServiceServer struct{}
+
+func (UnimplementedServiceServer) ResolveAll(context.Context, *ResolveAllRequest) (*ResolveAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveAll not implemented")
+}
+func (UnimplementedServiceServer) ResolveBoolean(context.Context, *ResolveBooleanRequest) (*ResolveBooleanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveBoolean not implemented")
+}
+func (UnimplementedServiceServer) ResolveString(context.Context, *ResolveStringRequest) (*ResolveStringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveString not implemented")
+}
+func (UnimplementedServiceServer) ResolveFloat(context.Context, *ResolveFloatRequest) (*ResolveFloatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveFloat not implemented")
+}
+func (UnimplementedServiceServer) ResolveInt(context.Context, *ResolveIntRequest) (*ResolveIntResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveInt not implemented")
+}
+func (UnimplementedServiceServer) ResolveObject(context.Context, *ResolveObjectRequest) (*ResolveObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveObject not implemented")
+}
+func (UnimplementedServiceServer) EventStream(*EventStreamRequest, grpc.ServerStreamingServer[EventStreamResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method EventStream not implemented")
+}
+func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
+func (UnimplementedServiceServer) testEmbeddedByValue()                 {}
+
+// UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ServiceServer will
+// result in compilation errors.
+type UnsafeServiceServer interface {
+	mustEmbedUnimplementedServiceServer()
+}
+
+func RegisterServiceServer(s grpc.ServiceRegistrar, srv ServiceServer) {
+	// If the following call pancis, it indicates UnimplementedServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Service_ServiceDesc, srv)
+}
+
+func _Service_ResolveAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ResolveAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_ResolveAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ResolveAll(ctx, req.(*ResolveAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ResolveBoolean_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveBooleanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ResolveBoolean(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_ResolveBoolean_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ResolveBoolean(ctx, req.(*ResolveBooleanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ResolveString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveStringRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ResolveString(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_ResolveString_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ResolveString(ctx, req.(*ResolveStringRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ResolveFloat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveFloatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ResolveFloat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_ResolveFloat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ResolveFloat(ctx, req.(*ResolveFloatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ResolveInt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveIntRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ResolveInt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_ResolveInt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ResolveInt(ctx, req.(*ResolveIntRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ResolveObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ResolveObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_ResolveObject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ResolveObject(ctx, req.(*ResolveObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_EventStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EventStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ServiceServer).EventStream(m, &grpc.GenericServerStream[EventStreamRequest, EventStreamResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Service_EventStreamServer = grpc.ServerStreamingServer[EventStreamResponse]
+
+// Service_ServiceDesc is the grpc.ServiceDesc for Service service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Service_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "flagd.evaluation.v1.Service",
+	HandlerType: (*ServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ResolveAll",
+			Handler:    _Service_ResolveAll_Handler,
+		},
+		{
+			MethodName: "ResolveBoolean",
+			Handler:    _Service_ResolveBoolean_Handler,
+		},
+		{
+			MethodName: "ResolveString",
+			Handler:    _Service_ResolveString_Handler,
+		},
+		{
+			MethodName: "ResolveFloat",
+			Handler:    _Service_ResolveFloat_Handler,
+		},
+		{
+			MethodName: "ResolveInt",
+			Handler:    _Service_ResolveInt_Handler,
+		},
+		{
+			MethodName: "ResolveObject",
+			Handler:    _Service_ResolveObject_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "EventStream",
+			Handler:       _Service_EventStream_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/flagd/evaluation.proto",
+}
@@ -22,13 +22,27 @@ const (
 )
 
 type Chunk struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          string                 `protobuf:"bytes,1,opt,name=Data,proto3" json:"Data,omitempty"`
-	Version       int32                  `protobuf:"varint,2,opt,name=Version,proto3" json:"Version,omitempty"`
-	InUse         bool                   `protobuf:"varint,3,opt,name=InUse,proto3" json:"InUse,omitempty"`
-	Project       string                 `protobuf:"bytes,4,opt,name=Project,proto3" json:"Project,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Data              string                 `protobuf:"bytes,1,opt,name=Data,proto3" json:"Data,omitempty"`
+	Version           int32                  `protobuf:"varint,2,opt,name=Version,proto3" json:"Version,omitempty"`
+	InUse             bool                   `protobuf:"varint,3,opt,name=InUse,proto3" json:"InUse,omitempty"`
+	Project           string                 `protobuf:"bytes,4,opt,name=Project,proto3" json:"Project,omitempty"`
+	Format            string                 `protobuf:"bytes,5,opt,name=Format,proto3" json:"Format,omitempty"`
+	SchemaVersion     int32                  `protobuf:"varint,6,opt,name=SchemaVersion,proto3" json:"SchemaVersion,omitempty"`
+	Author            string                 `protobuf:"bytes,7,opt,name=Author,proto3" json:"Author,omitempty"`
+	Environment       string                 `protobuf:"bytes,8,opt,name=Environment,proto3" json:"Environment,omitempty"`
+	SourceEnvironment string                 `protobuf:"bytes,9,opt,name=SourceEnvironment,proto3" json:"SourceEnvironment,omitempty"`
+	SourceVersion     int32                  `protobuf:"varint,10,opt,name=SourceVersion,proto3" json:"SourceVersion,omitempty"`
+	Layers            []*LayerVersion        `protobuf:"bytes,11,rep,name=Layers,proto3" json:"Layers,omitempty"`
+	Signature         string                 `protobuf:"bytes,12,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	SigningKey        string                 `protobuf:"bytes,13,opt,name=SigningKey,proto3" json:"SigningKey,omitempty"`
+	ProposalID        uint64                 `protobuf:"varint,14,opt,name=ProposalID,proto3" json:"ProposalID,omitempty"`
+	Hash              string                 `protobuf:"bytes,15,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	// Publish only while the active version or hash is still the expected one.
+	ExpectedVersion int32  `protobuf:"varint,16,opt,name=ExpectedVersion,proto3" json:"ExpectedVersion,omitempty"`
+	ExpectedHash    string `protobuf:"bytes,17,opt,name=ExpectedHash,proto3" json:"ExpectedHash,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Chunk) Reset() {
@@ -89,27 +103,119 @@ func (x *Chunk) GetProject() string {
 	return ""
 }
 
-type Resp struct {
+func (x *Chunk) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *Chunk) GetSchemaVersion() int32 {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return 0
+}
+
+func (x *Chunk) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *Chunk) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *Chunk) GetSourceEnvironment() string {
+	if x != nil {
+		return x.SourceEnvironment
+	}
+	return ""
+}
+
+func (x *Chunk) GetSourceVersion() int32 {
+	if x != nil {
+		return x.SourceVersion
+	}
+	return 0
+}
+
+func (x *Chunk) GetLayers() []*LayerVersion {
+	if x != nil {
+		return x.Layers
+	}
+	return nil
+}
+
+func (x *Chunk) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *Chunk) GetSigningKey() string {
+	if x != nil {
+		return x.SigningKey
+	}
+	return ""
+}
+
+func (x *Chunk) GetProposalID() uint64 {
+	if x != nil {
+		return x.ProposalID
+	}
+	return 0
+}
+
+func (x *Chunk) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *Chunk) GetExpectedVersion() int32 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *Chunk) GetExpectedHash() string {
+	if x != nil {
+		return x.ExpectedHash
+	}
+	return ""
+}
+
+type LayerVersion struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=Message,proto3" json:"Message,omitempty"`
+	Environment   string                 `protobuf:"bytes,1,opt,name=Environment,proto3" json:"Environment,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=Version,proto3" json:"Version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Resp) Reset() {
-	*x = Resp{}
+func (x *LayerVersion) Reset() {
+	*x = LayerVersion{}
 	mi := &file_proto_yoconf_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Resp) String() string {
+func (x *LayerVersion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Resp) ProtoMessage() {}
+func (*LayerVersion) ProtoMessage() {}
 
-func (x *Resp) ProtoReflect() protoreflect.Message {
+func (x *LayerVersion) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_yoconf_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -121,40 +227,54 @@ func (x *Resp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Resp.ProtoReflect.Descriptor instead.
-func (*Resp) Descriptor() ([]byte, []int) {
+// Deprecated: Use LayerVersion.ProtoReflect.Descriptor instead.
+func (*LayerVersion) Descriptor() ([]byte, []int) {
 	return file_proto_yoconf_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *Resp) GetMessage() string {
+func (x *LayerVersion) GetEnvironment() string {
 	if x != nil {
-		return x.Message
+		return x.Environment
 	}
 	return ""
 }
 
-type RollOnRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Project       string                 `protobuf:"bytes,1,opt,name=Project,proto3" json:"Project,omitempty"`
-	Version       int32                  `protobuf:"varint,2,opt,name=Version,proto3" json:"Version,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *LayerVersion) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
 }
 
-func (x *RollOnRequest) Reset() {
-	*x = RollOnRequest{}
+type Project struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Name              string                 `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Format            string                 `protobuf:"bytes,2,opt,name=Format,proto3" json:"Format,omitempty"`
+	SchemaVersion     int32                  `protobuf:"varint,3,opt,name=SchemaVersion,proto3" json:"SchemaVersion,omitempty"`
+	PromotionPath     []string               `protobuf:"bytes,4,rep,name=PromotionPath,proto3" json:"PromotionPath,omitempty"`
+	Layers            []*LayerSpec           `protobuf:"bytes,5,rep,name=Layers,proto3" json:"Layers,omitempty"`
+	Protected         bool                   `protobuf:"varint,6,opt,name=Protected,proto3" json:"Protected,omitempty"`
+	RequiredApprovals int32                  `protobuf:"varint,7,opt,name=RequiredApprovals,proto3" json:"RequiredApprovals,omitempty"`
+	Approvers         []string               `protobuf:"bytes,8,rep,name=Approvers,proto3" json:"Approvers,omitempty"`
+	Type              string                 `protobuf:"bytes,9,opt,name=Type,proto3" json:"Type,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Project) Reset() {
+	*x = Project{}
 	mi := &file_proto_yoconf_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RollOnRequest) String() string {
+func (x *Project) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RollOnRequest) ProtoMessage() {}
+func (*Project) ProtoMessage() {}
 
-func (x *RollOnRequest) ProtoReflect() protoreflect.Message {
+func (x *Project) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_yoconf_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -166,47 +286,96 @@ func (x *RollOnRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RollOnRequest.ProtoReflect.Descriptor instead.
-func (*RollOnRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Project.ProtoReflect.Descriptor instead.
+func (*Project) Descriptor() ([]byte, []int) {
 	return file_proto_yoconf_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *RollOnRequest) GetProject() string {
+func (x *Project) GetName() string {
 	if x != nil {
-		return x.Project
+		return x.Name
 	}
 	return ""
 }
 
-func (x *RollOnRequest) GetVersion() int32 {
+func (x *Project) GetFormat() string {
 	if x != nil {
-		return x.Version
+		return x.Format
+	}
+	return ""
+}
+
+func (x *Project) GetSchemaVersion() int32 {
+	if x != nil {
+		return x.SchemaVersion
 	}
 	return 0
 }
 
-type DeleteRequest struct {
+func (x *Project) GetPromotionPath() []string {
+	if x != nil {
+		return x.PromotionPath
+	}
+	return nil
+}
+
+func (x *Project) GetLayers() []*LayerSpec {
+	if x != nil {
+		return x.Layers
+	}
+	return nil
+}
+
+func (x *Project) GetProtected() bool {
+	if x != nil {
+		return x.Protected
+	}
+	return false
+}
+
+func (x *Project) GetRequiredApprovals() int32 {
+	if x != nil {
+		return x.RequiredApprovals
+	}
+	return 0
+}
+
+func (x *Project) GetApprovers() []string {
+	if x != nil {
+		return x.Approvers
+	}
+	return nil
+}
+
+func (x *Project) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type LayerSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Project       string                 `protobuf:"bytes,1,opt,name=Project,proto3" json:"Project,omitempty"`
-	Version       int32                  `protobuf:"varint,2,opt,name=Version,proto3" json:"Version,omitempty"`
+	Environment   string                 `protobuf:"bytes,1,opt,name=Environment,proto3" json:"Environment,omitempty"`
+	Layers        []string               `protobuf:"bytes,2,rep,name=Layers,proto3" json:"Layers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteRequest) Reset() {
-	*x = DeleteRequest{}
+func (x *LayerSpec) Reset() {
+	*x = LayerSpec{}
 	mi := &file_proto_yoconf_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteRequest) String() string {
+func (x *LayerSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRequest) ProtoMessage() {}
+func (*LayerSpec) ProtoMessage() {}
 
-func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
+func (x *LayerSpec) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_yoconf_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -218,47 +387,4203 @@ func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRequest.ProtoReflect.Descriptor instead.
-func (*DeleteRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LayerSpec.ProtoReflect.Descriptor instead.
+func (*LayerSpec) Descriptor() ([]byte, []int) {
 	return file_proto_yoconf_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *DeleteRequest) GetProject() string {
+func (x *LayerSpec) GetEnvironment() string {
 	if x != nil {
-		return x.Project
+		return x.Environment
 	}
 	return ""
 }
 
-func (x *DeleteRequest) GetVersion() int32 {
+func (x *LayerSpec) GetLayers() []string {
 	if x != nil {
-		return x.Version
+		return x.Layers
 	}
-	return 0
+	return nil
 }
 
-var File_proto_yoconf_proto protoreflect.FileDescriptor
+type GetProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_proto_yoconf_proto_rawDesc = "" +
-	"\n" +
-	"\x12proto/yoconf.proto\"e\n" +
-	"\x05Chunk\x12\x12\n" +
-	"\x04Data\x18\x01 \x01(\tR\x04Data\x12\x18\n" +
-	"\aVersion\x18\x02 \x01(\x05R\aVersion\x12\x14\n" +
-	"\x05InUse\x18\x03 \x01(\bR\x05InUse\x12\x18\n" +
-	"\aProject\x18\x04 \x01(\tR\aProject\" \n" +
-	"\x04Resp\x12\x18\n" +
-	"\aMessage\x18\x01 \x01(\tR\aMessage\"C\n" +
-	"\rRollOnRequest\x12\x18\n" +
-	"\aProject\x18\x01 \x01(\tR\aProject\x12\x18\n" +
-	"\aVersion\x18\x02 \x01(\x05R\aVersion\"C\n" +
-	"\rDeleteRequest\x12\x18\n" +
-	"\aProject\x18\x01 \x01(\tR\aProject\x12\x18\n" +
-	"\aVersion\x18\x02 \x01(\x05R\aVersion2m\n" +
-	"\x06YoConf\x12\x1c\n" +
-	"\vCreateChunk\x12\x06.Chunk\x1a\x05.Resp\x12\x1f\n" +
-	"\x06RollOn\x12\x0e.RollOnRequest\x1a\x05.Resp\x12$\n" +
-	"\vDeleteChunk\x12\x0e.DeleteRequest\x1a\x05.RespB\x06Z\x04./pbb\x06proto3"
+func (x *GetProjectRequest) Reset() {
+	*x = GetProjectRequest{}
+	mi := &file_proto_yoconf_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectRequest) ProtoMessage() {}
+
+func (x *GetProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectRequest.ProtoReflect.Descriptor instead.
+func (*GetProjectRequest) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetProjectRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Resp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=Message,proto3" json:"Message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Resp) Reset() {
+	*x = Resp{}
+	mi := &file_proto_yoconf_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Resp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Resp) ProtoMessage() {}
+
+func (x *Resp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Resp.ProtoReflect.Descriptor instead.
+func (*Resp) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Resp) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type RollOnRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Project         string                 `protobuf:"bytes,1,opt,name=Project,proto3" json:"Project,omitempty"`
+	Version         int32                  `protobuf:"varint,2,opt,name=Version,proto3" json:"Version,omitempty"`
+	Environment     string                 `protobuf:"bytes,3,opt,name=Environment,proto3" json:"Environment,omitempty"`
+	ExpectedVersion int32                  `protobuf:"varint,4,opt,name=ExpectedVersion,proto3" json:"ExpectedVersion,omitempty"`
+	ExpectedHash    string                 `protobuf:"bytes,5,opt,name=ExpectedHash,proto3" json:"ExpectedHash,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RollOnRequest) Reset() {
+	*x = RollOnRequest{}
+	mi := &file_proto_yoconf_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollOnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollOnRequest) ProtoMessage() {}
+
+func (x *RollOnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollOnRequest.ProtoReflect.Descriptor instead.
+func (*RollOnRequest) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RollOnRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *RollOnRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RollOnRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *RollOnRequest) GetExpectedVersion() int32 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *RollOnRequest) GetExpectedHash() string {
+	if x != nil {
+		return x.ExpectedHash
+	}
+	return ""
+}
+
+type DeleteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       string                 `protobuf:"bytes,1,opt,name=Project,proto3" json:"Project,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=Version,proto3" json:"Version,omitempty"`
+	Environment   string                 `protobuf:"bytes,3,opt,name=Environment,proto3" json:"Environment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRequest) Reset() {
+	*x = DeleteRequest{}
+	mi := &file_proto_yoconf_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRequest) ProtoMessage() {}
+
+func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *DeleteRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *DeleteRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+type DiffRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       string                 `protobuf:"bytes,1,opt,name=Project,proto3" json:"Project,omitempty"`
+	From          string                 `protobuf:"bytes,2,opt,name=From,proto3" json:"From,omitempty"`
+	To            string                 `protobuf:"bytes,3,opt,name=To,proto3" json:"To,omitempty"`
+	Environment   string                 `protobuf:"bytes,4,opt,name=Environment,proto3" json:"Environment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffRequest) Reset() {
+	*x = DiffRequest{}
+	mi := &file_proto_yoconf_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffRequest) ProtoMessage() {}
+
+func (x *DiffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffRequest.ProtoReflect.Descriptor instead.
+func (*DiffRequest) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DiffRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *DiffRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *DiffRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *DiffRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+type Change struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	Old           string                 `protobuf:"bytes,3,opt,name=Old,proto3" json:"Old,omitempty"`
+	New           string                 `protobuf:"bytes,4,opt,name=New,proto3" json:"New,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Change) Reset() {
+	*x = Change{}
+	mi := &file_proto_yoconf_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Change) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Change) ProtoMessage() {}
+
+func (x *Change) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Change.ProtoReflect.Descriptor instead.
+func (*Change) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Change) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Change) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Change) GetOld() string {
+	if x != nil {
+		return x.Old
+	}
+	return ""
+}
+
+func (x *Change) GetNew() string {
+	if x != nil {
+		return x.New
+	}
+	return ""
+}
+
+type DiffResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       string                 `protobuf:"bytes,1,opt,name=Project,proto3" json:"Project,omitempty"`
+	FromVersion   int32                  `protobuf:"varint,2,opt,name=FromVersion,proto3" json:"FromVersion,omitempty"`
+	ToVersion     int32                  `protobuf:"varint,3,opt,name=ToVersion,proto3" json:"ToVersion,omitempty"`
+	Unified       string                 `protobuf:"bytes,4,opt,name=Unified,proto3" json:"Unified,omitempty"`
+	Structured    bool                   `protobuf:"varint,5,opt,name=Structured,proto3" json:"Structured,omitempty"`
+	Changes       []*Change              `protobuf:"bytes,6,rep,name=Changes,proto3" json:"Changes,omitempty"`
+	Environment   string                 `protobuf:"bytes,7,opt,name=Environment,proto3" json:"Environment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffResponse) Reset() {
+	*x = DiffResponse{}
+	mi := &file_proto_yoconf_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffResponse) ProtoMessage() {}
+
+func (x *DiffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffResponse.ProtoReflect.Descriptor instead.
+func (*DiffResponse) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DiffResponse) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *DiffResponse) GetFromVersion() int32 {
+	if x != nil {
+		return x.FromVersion
+	}
+	return 0
+}
+
+func (x *DiffResponse) GetToVersion() int32 {
+	if x != nil {
+		return x.ToVersion
+	}
+	return 0
+}
+
+func (x *DiffResponse) GetUnified() string {
+	if x != nil {
+		return x.Unified
+	}
+	return ""
+}
+
+func (x *DiffResponse) GetStructured() bool {
+	if x != nil {
+		return x.Structured
+	}
+	return false
+}
+
+func (x *DiffResponse) GetChanges() []*Change {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *DiffResponse) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+type Schema struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       string                 `protobuf:"bytes,1,opt,name=Project,proto3" json:"Project,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=Version,proto3" json:"Version,omitempty"`
+	Data          string                 `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Schema) Reset() {
+	*x = Schema{}
+	mi := &file_proto_yoconf_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Schema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Schema) ProtoMessage() {}
+
+func (x *Schema) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Schema.ProtoReflect.Descriptor instead.
+func (*Schema) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Schema) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *Schema) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Schema) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+type GetSchemaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       string                 `protobuf:"bytes,1,opt,name=Project,proto3" json:"Project,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=Version,proto3" json:"Version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSchemaRequest) Reset() {
+	*x = GetSchemaRequest{}
+	mi := &file_proto_yoconf_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSchemaRequest) ProtoMessage() {}
+
+func (x *GetSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSchemaRequest.ProtoReflect.Descriptor instead.
+func (*GetSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetSchemaRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *GetSchemaRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type Violation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
+	Keyword       string                 `protobuf:"bytes,2,opt,name=Keyword,proto3" json:"Keyword,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=Message,proto3" json:"Message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Violation) Reset() {
+	*x = Violation{}
+	mi := &file_proto_yoconf_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Violation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Violation) ProtoMessage() {}
+
+func (x *Violation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Violation.ProtoReflect.Descriptor instead.
+func (*Violation) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Violation) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Violation) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *Violation) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type RuleResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          string                 `protobuf:"bytes,1,opt,name=Rule,proto3" json:"Rule,omitempty"`
+	Mode          string                 `protobuf:"bytes,2,opt,name=Mode,proto3" json:"Mode,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=Message,proto3" json:"Message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RuleResult) Reset() {
+	*x = RuleResult{}
+	mi := &file_proto_yoconf_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuleResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleResult) ProtoMessage() {}
+
+func (x *RuleResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleResult.ProtoReflect.Descriptor instead.
+func (*RuleResult) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RuleResult) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *RuleResult) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *RuleResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ValidateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=Valid,proto3" json:"Valid,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=Message,proto3" json:"Message,omitempty"`
+	Violations    []*Violation           `protobuf:"bytes,3,rep,name=Violations,proto3" json:"Violations,omitempty"`
+	Failures      []*RuleResult          `protobuf:"bytes,4,rep,name=Failures,proto3" json:"Failures,omitempty"`
+	Warnings      []*RuleResult          `protobuf:"bytes,5,rep,name=Warnings,proto3" json:"Warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateResponse) Reset() {
+	*x = ValidateResponse{}
+	mi := &file_proto_yoconf_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateResponse) ProtoMessage() {}
+
+func (x *ValidateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateResponse.ProtoReflect.Descriptor instead.
+func (*ValidateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ValidateResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ValidateResponse) GetViolations() []*Violation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+func (x *ValidateResponse) GetFailures() []*RuleResult {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+func (x *ValidateResponse) GetWarnings() []*RuleResult {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type Rule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       string                 `protobuf:"bytes,1,opt,name=Project,proto3" json:"Project,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Expression    string                 `protobuf:"bytes,3,opt,name=Expression,proto3" json:"Expression,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=Message,proto3" json:"Message,omitempty"`
+	Mode          string                 `protobuf:"bytes,5,opt,name=Mode,proto3" json:"Mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Rule) Reset() {
+	*x = Rule{}
+	mi := &file_proto_yoconf_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rule) ProtoMessage() {}
+
+func (x *Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rule.ProtoReflect.Descriptor instead.
+func (*Rule) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Rule) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *Rule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Rule) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *Rule) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Rule) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+type DeleteRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       string                 `protobuf:"bytes,1,opt,name=Project,proto3" json:"Project,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRuleRequest) Reset() {
+	*x = DeleteRuleRequest{}
+	mi := &file_proto_yoconf_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRuleRequest) ProtoMessage() {}
+
+func (x *DeleteRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DeleteRuleRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *DeleteRuleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       string                 `protobuf:"bytes,1,opt,name=Project,proto3" json:"Project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRulesRequest) Reset() {
+	*x = ListRulesRequest{}
+	mi := &file_proto_yoconf_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRulesRequest) ProtoMessage() {}
+
+func (x *ListRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListRulesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListRulesRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+type RulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*Rule                `protobuf:"bytes,1,rep,name=Rules,proto3" json:"Rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RulesResponse) Reset() {
+	*x = RulesResponse{}
+	mi := &file_proto_yoconf_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RulesResponse) ProtoMessage() {}
+
+func (x *RulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RulesResponse.ProtoReflect.Descriptor instead.
+func (*RulesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RulesResponse) GetRules() []*Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type ListEnvironmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       string                 `protobuf:"bytes,1,opt,name=Project,proto3" json:"Project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEnvironmentsRequest) Reset() {
+	*x = ListEnvironmentsRequest{}
+	mi := &file_proto_yoconf_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEnvironmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEnvironmentsRequest) ProtoMessage() {}
+
+func (x *ListEnvironmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEnvironmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListEnvironmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListEnvironmentsRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+type EnvironmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       string                 `protobuf:"bytes,1,opt,name=Project,proto3" json:"Project,omitempty"`
+	Environments  []string               `protobuf:"bytes,2,rep,name=Environments,proto3" json:"Environments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnvironmentsResponse) Reset() {
+	*x = EnvironmentsResponse{}
+	mi := &file_proto_yoconf_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnvironmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnvironmentsResponse) ProtoMessage() {}
+
+func (x *EnvironmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnvironmentsResponse.ProtoReflect.Descriptor instead.
+func (*EnvironmentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *EnvironmentsResponse) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *EnvironmentsResponse) GetEnvironments() []string {
+	if x != nil {
+		return x.Environments
+	}
+	return nil
+}
+
+type PromoteRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Project           string                 `protobuf:"bytes,1,opt,name=Project,proto3" json:"Project,omitempty"`
+	SourceEnvironment string                 `protobuf:"bytes,2,opt,name=SourceEnvironment,proto3" json:"SourceEnvironment,omitempty"`
+	Version           int32                  `protobuf:"varint,3,opt,name=Version,proto3" json:"Version,omitempty"`
+	TargetEnvironment string                 `protobuf:"bytes,4,opt,name=TargetEnvironment,proto3" json:"TargetEnvironment,omitempty"`
+	Author            string                 `protobuf:"bytes,5,opt,name=Author,proto3" json:"Author,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PromoteRequest) Reset() {
+	*x = PromoteRequest{}
+	mi := &file_proto_yoconf_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteRequest) ProtoMessage() {}
+
+func (x *PromoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteRequest.ProtoReflect.Descriptor instead.
+func (*PromoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PromoteRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *PromoteRequest) GetSourceEnvironment() string {
+	if x != nil {
+		return x.SourceEnvironment
+	}
+	return ""
+}
+
+func (x *PromoteRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *PromoteRequest) GetTargetEnvironment() string {
+	if x != nil {
+		return x.TargetEnvironment
+	}
+	return ""
+}
+
+func (x *PromoteRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+// PatchRequest carries an RFC 6902 JSON patch or RFC 7396 merge patch of
+// BaseVersion, which must still be active.
+type PatchRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Project     string                 `protobuf:"bytes,1,opt,name=Project,proto3" json:"Project,omitempty"`
+	Environment string                 `protobuf:"bytes,2,opt,name=Environment,proto3" json:"Environment,omitempty"`
+	BaseVersion int32                  `protobuf:"varint,3,opt,name=BaseVersion,proto3" json:"BaseVersion,omitempty"`
+	// Type is json-patch or merge-patch, or their media types.
+	Type          string `protobuf:"bytes,4,opt,name=Type,proto3" json:"Type,omitempty"`
+	Patch         string `protobuf:"bytes,5,opt,name=Patch,proto3" json:"Patch,omitempty"`
+	Author        string `protobuf:"bytes,6,opt,name=Author,proto3" json:"Author,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchRequest) Reset() {
+	*x = PatchRequest{}
+	mi := &file_proto_yoconf_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchRequest) ProtoMessage() {}
+
+func (x *PatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchRequest.ProtoReflect.Descriptor instead.
+func (*PatchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PatchRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *PatchRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *PatchRequest) GetBaseVersion() int32 {
+	if x != nil {
+		return x.BaseVersion
+	}
+	return 0
+}
+
+func (x *PatchRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PatchRequest) GetPatch() string {
+	if x != nil {
+		return x.Patch
+	}
+	return ""
+}
+
+func (x *PatchRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+type Dependency struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Project           string                 `protobuf:"bytes,1,opt,name=Project,proto3" json:"Project,omitempty"`
+	Environment       string                 `protobuf:"bytes,2,opt,name=Environment,proto3" json:"Environment,omitempty"`
+	TargetProject     string                 `protobuf:"bytes,3,opt,name=TargetProject,proto3" json:"TargetProject,omitempty"`
+	TargetEnvironment string                 `protobuf:"bytes,4,opt,name=TargetEnvironment,proto3" json:"TargetEnvironment,omitempty"`
+	TargetVersion     int32                  `protobuf:"varint,5,opt,name=TargetVersion,proto3" json:"TargetVersion,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Dependency) Reset() {
+	*x = Dependency{}
+	mi := &file_proto_yoconf_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Dependency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Dependency) ProtoMessage() {}
+
+func (x *Dependency) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Dependency.ProtoReflect.Descriptor instead.
+func (*Dependency) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Dependency) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *Dependency) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *Dependency) GetTargetProject() string {
+	if x != nil {
+		return x.TargetProject
+	}
+	return ""
+}
+
+func (x *Dependency) GetTargetEnvironment() string {
+	if x != nil {
+		return x.TargetEnvironment
+	}
+	return ""
+}
+
+func (x *Dependency) GetTargetVersion() int32 {
+	if x != nil {
+		return x.TargetVersion
+	}
+	return 0
+}
+
+type ListDependenciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       string                 `protobuf:"bytes,1,opt,name=Project,proto3" json:"Project,omitempty"`
+	Environment   string                 `protobuf:"bytes,2,opt,name=Environment,proto3" json:"Environment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDependenciesRequest) Reset() {
+	*x = ListDependenciesRequest{}
+	mi := &file_proto_yoconf_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDependenciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDependenciesRequest) ProtoMessage() {}
+
+func (x *ListDependenciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDependenciesRequest.ProtoReflect.Descriptor instead.
+func (*ListDependenciesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListDependenciesRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *ListDependenciesRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+type DependenciesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dependencies  []*Dependency          `protobuf:"bytes,1,rep,name=Dependencies,proto3" json:"Dependencies,omitempty"`
+	Dependents    []*Dependency          `protobuf:"bytes,2,rep,name=Dependents,proto3" json:"Dependents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DependenciesResponse) Reset() {
+	*x = DependenciesResponse{}
+	mi := &file_proto_yoconf_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DependenciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DependenciesResponse) ProtoMessage() {}
+
+func (x *DependenciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DependenciesResponse.ProtoReflect.Descriptor instead.
+func (*DependenciesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DependenciesResponse) GetDependencies() []*Dependency {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+func (x *DependenciesResponse) GetDependents() []*Dependency {
+	if x != nil {
+		return x.Dependents
+	}
+	return nil
+}
+
+type GetConfigRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Project     string                 `protobuf:"bytes,1,opt,name=Project,proto3" json:"Project,omitempty"`
+	Environment string                 `protobuf:"bytes,2,opt,name=Environment,proto3" json:"Environment,omitempty"`
+	ClientID    string                 `protobuf:"bytes,3,opt,name=ClientID,proto3" json:"ClientID,omitempty"`
+	Labels      map[string]string      `protobuf:"bytes,4,rep,name=Labels,proto3" json:"Labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Path selects one value or subtree, as a dotted path or JSONPath.
+	Path string `protobuf:"bytes,5,opt,name=Path,proto3" json:"Path,omitempty"`
+	// Format encodes the selected value; the chunk format by default.
+	Format        string `protobuf:"bytes,6,opt,name=Format,proto3" json:"Format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConfigRequest) Reset() {
+	*x = GetConfigRequest{}
+	mi := &file_proto_yoconf_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigRequest) ProtoMessage() {}
+
+func (x *GetConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetConfigRequest) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetConfigRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *GetConfigRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *GetConfigRequest) GetClientID() string {
+	if x != nil {
+		return x.ClientID
+	}
+	return ""
+}
+
+func (x *GetConfigRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *GetConfigRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *GetConfigRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type PublicKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ID            string                 `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Algorithm     string                 `protobuf:"bytes,2,opt,name=Algorithm,proto3" json:"Algorithm,omitempty"`
+	PublicKey     string                 `protobuf:"bytes,3,opt,name=PublicKey,proto3" json:"PublicKey,omitempty"`
+	Current       bool                   `protobuf:"varint,4,opt,name=Current,proto3" json:"Current,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublicKey) Reset() {
+	*x = PublicKey{}
+	mi := &file_proto_yoconf_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublicKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicKey) ProtoMessage() {}
+
+func (x *PublicKey) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicKey.ProtoReflect.Descriptor instead.
+func (*PublicKey) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PublicKey) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *PublicKey) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *PublicKey) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *PublicKey) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+type ListSigningKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSigningKeysRequest) Reset() {
+	*x = ListSigningKeysRequest{}
+	mi := &file_proto_yoconf_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSigningKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSigningKeysRequest) ProtoMessage() {}
+
+func (x *ListSigningKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSigningKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListSigningKeysRequest) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{29}
+}
+
+type SigningKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []*PublicKey           `protobuf:"bytes,1,rep,name=Keys,proto3" json:"Keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SigningKeysResponse) Reset() {
+	*x = SigningKeysResponse{}
+	mi := &file_proto_yoconf_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SigningKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SigningKeysResponse) ProtoMessage() {}
+
+func (x *SigningKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SigningKeysResponse.ProtoReflect.Descriptor instead.
+func (*SigningKeysResponse) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SigningKeysResponse) GetKeys() []*PublicKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type RolloutStep struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Percent       int32                  `protobuf:"varint,1,opt,name=Percent,proto3" json:"Percent,omitempty"`
+	At            int64                  `protobuf:"varint,2,opt,name=At,proto3" json:"At,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RolloutStep) Reset() {
+	*x = RolloutStep{}
+	mi := &file_proto_yoconf_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RolloutStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloutStep) ProtoMessage() {}
+
+func (x *RolloutStep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloutStep.ProtoReflect.Descriptor instead.
+func (*RolloutStep) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RolloutStep) GetPercent() int32 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *RolloutStep) GetAt() int64 {
+	if x != nil {
+		return x.At
+	}
+	return 0
+}
+
+type Rollout struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       string                 `protobuf:"bytes,1,opt,name=Project,proto3" json:"Project,omitempty"`
+	Environment   string                 `protobuf:"bytes,2,opt,name=Environment,proto3" json:"Environment,omitempty"`
+	Version       int32                  `protobuf:"varint,3,opt,name=Version,proto3" json:"Version,omitempty"`
+	Percent       int32                  `protobuf:"varint,4,opt,name=Percent,proto3" json:"Percent,omitempty"`
+	Steps         []*RolloutStep         `protobuf:"bytes,5,rep,name=Steps,proto3" json:"Steps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Rollout) Reset() {
+	*x = Rollout{}
+	mi := &file_proto_yoconf_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rollout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rollout) ProtoMessage() {}
+
+func (x *Rollout) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rollout.ProtoReflect.Descriptor instead.
+func (*Rollout) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Rollout) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *Rollout) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *Rollout) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Rollout) GetPercent() int32 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *Rollout) GetSteps() []*RolloutStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+type RolloutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       string                 `protobuf:"bytes,1,opt,name=Project,proto3" json:"Project,omitempty"`
+	Environment   string                 `protobuf:"bytes,2,opt,name=Environment,proto3" json:"Environment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RolloutRequest) Reset() {
+	*x = RolloutRequest{}
+	mi := &file_proto_yoconf_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RolloutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloutRequest) ProtoMessage() {}
+
+func (x *RolloutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloutRequest.ProtoReflect.Descriptor instead.
+func (*RolloutRequest) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RolloutRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *RolloutRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+type RolloutPercentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       string                 `protobuf:"bytes,1,opt,name=Project,proto3" json:"Project,omitempty"`
+	Environment   string                 `protobuf:"bytes,2,opt,name=Environment,proto3" json:"Environment,omitempty"`
+	Percent       int32                  `protobuf:"varint,3,opt,name=Percent,proto3" json:"Percent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RolloutPercentRequest) Reset() {
+	*x = RolloutPercentRequest{}
+	mi := &file_proto_yoconf_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RolloutPercentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloutPercentRequest) ProtoMessage() {}
+
+func (x *RolloutPercentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloutPercentRequest.ProtoReflect.Descriptor instead.
+func (*RolloutPercentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RolloutPercentRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *RolloutPercentRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *RolloutPercentRequest) GetPercent() int32 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+type TargetRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Selector      string                 `protobuf:"bytes,2,opt,name=Selector,proto3" json:"Selector,omitempty"`
+	Version       int32                  `protobuf:"varint,3,opt,name=Version,proto3" json:"Version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TargetRule) Reset() {
+	*x = TargetRule{}
+	mi := &file_proto_yoconf_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TargetRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TargetRule) ProtoMessage() {}
+
+func (x *TargetRule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TargetRule.ProtoReflect.Descriptor instead.
+func (*TargetRule) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *TargetRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TargetRule) GetSelector() string {
+	if x != nil {
+		return x.Selector
+	}
+	return ""
+}
+
+func (x *TargetRule) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type Targeting struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Project        string                 `protobuf:"bytes,1,opt,name=Project,proto3" json:"Project,omitempty"`
+	Environment    string                 `protobuf:"bytes,2,opt,name=Environment,proto3" json:"Environment,omitempty"`
+	Rules          []*TargetRule          `protobuf:"bytes,3,rep,name=Rules,proto3" json:"Rules,omitempty"`
+	DefaultVersion int32                  `protobuf:"varint,4,opt,name=DefaultVersion,proto3" json:"DefaultVersion,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Targeting) Reset() {
+	*x = Targeting{}
+	mi := &file_proto_yoconf_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Targeting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Targeting) ProtoMessage() {}
+
+func (x *Targeting) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Targeting.ProtoReflect.Descriptor instead.
+func (*Targeting) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Targeting) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *Targeting) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *Targeting) GetRules() []*TargetRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *Targeting) GetDefaultVersion() int32 {
+	if x != nil {
+		return x.DefaultVersion
+	}
+	return 0
+}
+
+type GetTargetingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       string                 `protobuf:"bytes,1,opt,name=Project,proto3" json:"Project,omitempty"`
+	Environment   string                 `protobuf:"bytes,2,opt,name=Environment,proto3" json:"Environment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTargetingRequest) Reset() {
+	*x = GetTargetingRequest{}
+	mi := &file_proto_yoconf_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTargetingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTargetingRequest) ProtoMessage() {}
+
+func (x *GetTargetingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTargetingRequest.ProtoReflect.Descriptor instead.
+func (*GetTargetingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetTargetingRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *GetTargetingRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+type EvaluateTargetingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       string                 `protobuf:"bytes,1,opt,name=Project,proto3" json:"Project,omitempty"`
+	Environment   string                 `protobuf:"bytes,2,opt,name=Environment,proto3" json:"Environment,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,3,rep,name=Labels,proto3" json:"Labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluateTargetingRequest) Reset() {
+	*x = EvaluateTargetingRequest{}
+	mi := &file_proto_yoconf_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluateTargetingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateTargetingRequest) ProtoMessage() {}
+
+func (x *EvaluateTargetingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateTargetingRequest.ProtoReflect.Descriptor instead.
+func (*EvaluateTargetingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *EvaluateTargetingRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *EvaluateTargetingRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *EvaluateTargetingRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type TargetingResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          string                 `protobuf:"bytes,1,opt,name=Rule,proto3" json:"Rule,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=Version,proto3" json:"Version,omitempty"`
+	Default       bool                   `protobuf:"varint,3,opt,name=Default,proto3" json:"Default,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TargetingResult) Reset() {
+	*x = TargetingResult{}
+	mi := &file_proto_yoconf_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TargetingResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TargetingResult) ProtoMessage() {}
+
+func (x *TargetingResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TargetingResult.ProtoReflect.Descriptor instead.
+func (*TargetingResult) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *TargetingResult) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *TargetingResult) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *TargetingResult) GetDefault() bool {
+	if x != nil {
+		return x.Default
+	}
+	return false
+}
+
+type Schedule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ID            uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Project       string                 `protobuf:"bytes,2,opt,name=Project,proto3" json:"Project,omitempty"`
+	Environment   string                 `protobuf:"bytes,3,opt,name=Environment,proto3" json:"Environment,omitempty"`
+	Version       int32                  `protobuf:"varint,4,opt,name=Version,proto3" json:"Version,omitempty"`
+	At            int64                  `protobuf:"varint,5,opt,name=At,proto3" json:"At,omitempty"`
+	Author        string                 `protobuf:"bytes,6,opt,name=Author,proto3" json:"Author,omitempty"`
+	Status        string                 `protobuf:"bytes,7,opt,name=Status,proto3" json:"Status,omitempty"`
+	Error         string                 `protobuf:"bytes,8,opt,name=Error,proto3" json:"Error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Schedule) Reset() {
+	*x = Schedule{}
+	mi := &file_proto_yoconf_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Schedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Schedule) ProtoMessage() {}
+
+func (x *Schedule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Schedule.ProtoReflect.Descriptor instead.
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *Schedule) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Schedule) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *Schedule) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *Schedule) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Schedule) GetAt() int64 {
+	if x != nil {
+		return x.At
+	}
+	return 0
+}
+
+func (x *Schedule) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *Schedule) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Schedule) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ListSchedulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       string                 `protobuf:"bytes,1,opt,name=Project,proto3" json:"Project,omitempty"`
+	Environment   string                 `protobuf:"bytes,2,opt,name=Environment,proto3" json:"Environment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSchedulesRequest) Reset() {
+	*x = ListSchedulesRequest{}
+	mi := &file_proto_yoconf_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchedulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchedulesRequest) ProtoMessage() {}
+
+func (x *ListSchedulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchedulesRequest.ProtoReflect.Descriptor instead.
+func (*ListSchedulesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListSchedulesRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *ListSchedulesRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+type SchedulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedules     []*Schedule            `protobuf:"bytes,1,rep,name=Schedules,proto3" json:"Schedules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchedulesResponse) Reset() {
+	*x = SchedulesResponse{}
+	mi := &file_proto_yoconf_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulesResponse) ProtoMessage() {}
+
+func (x *SchedulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulesResponse.ProtoReflect.Descriptor instead.
+func (*SchedulesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SchedulesResponse) GetSchedules() []*Schedule {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+type CancelScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ID            uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScheduleRequest) Reset() {
+	*x = CancelScheduleRequest{}
+	mi := &file_proto_yoconf_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduleRequest) ProtoMessage() {}
+
+func (x *CancelScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduleRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CancelScheduleRequest) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type AuditEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ID            uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Time          int64                  `protobuf:"varint,2,opt,name=Time,proto3" json:"Time,omitempty"`
+	Actor         string                 `protobuf:"bytes,3,opt,name=Actor,proto3" json:"Actor,omitempty"`
+	Action        string                 `protobuf:"bytes,4,opt,name=Action,proto3" json:"Action,omitempty"`
+	Project       string                 `protobuf:"bytes,5,opt,name=Project,proto3" json:"Project,omitempty"`
+	Environment   string                 `protobuf:"bytes,6,opt,name=Environment,proto3" json:"Environment,omitempty"`
+	Version       int32                  `protobuf:"varint,7,opt,name=Version,proto3" json:"Version,omitempty"`
+	Detail        string                 `protobuf:"bytes,8,opt,name=Detail,proto3" json:"Detail,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
+	mi := &file_proto_yoconf_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntry) ProtoMessage() {}
+
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *AuditEntry) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+type ListAuditRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       string                 `protobuf:"bytes,1,opt,name=Project,proto3" json:"Project,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditRequest) Reset() {
+	*x = ListAuditRequest{}
+	mi := &file_proto_yoconf_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditRequest) ProtoMessage() {}
+
+func (x *ListAuditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditRequest) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListAuditRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *ListAuditRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type AuditResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*AuditEntry          `protobuf:"bytes,1,rep,name=Entries,proto3" json:"Entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditResponse) Reset() {
+	*x = AuditResponse{}
+	mi := &file_proto_yoconf_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditResponse) ProtoMessage() {}
+
+func (x *AuditResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditResponse.ProtoReflect.Descriptor instead.
+func (*AuditResponse) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *AuditResponse) GetEntries() []*AuditEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type Override struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Project         string                 `protobuf:"bytes,1,opt,name=Project,proto3" json:"Project,omitempty"`
+	Environment     string                 `protobuf:"bytes,2,opt,name=Environment,proto3" json:"Environment,omitempty"`
+	Version         int32                  `protobuf:"varint,3,opt,name=Version,proto3" json:"Version,omitempty"`
+	PreviousVersion int32                  `protobuf:"varint,4,opt,name=PreviousVersion,proto3" json:"PreviousVersion,omitempty"`
+	ExpiresAt       int64                  `protobuf:"varint,5,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
+	Author          string                 `protobuf:"bytes,6,opt,name=Author,proto3" json:"Author,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Override) Reset() {
+	*x = Override{}
+	mi := &file_proto_yoconf_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Override) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Override) ProtoMessage() {}
+
+func (x *Override) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Override.ProtoReflect.Descriptor instead.
+func (*Override) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *Override) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *Override) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *Override) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Override) GetPreviousVersion() int32 {
+	if x != nil {
+		return x.PreviousVersion
+	}
+	return 0
+}
+
+func (x *Override) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *Override) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+type StartOverrideRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Project         string                 `protobuf:"bytes,1,opt,name=Project,proto3" json:"Project,omitempty"`
+	Environment     string                 `protobuf:"bytes,2,opt,name=Environment,proto3" json:"Environment,omitempty"`
+	Version         int32                  `protobuf:"varint,3,opt,name=Version,proto3" json:"Version,omitempty"`
+	DurationSeconds int64                  `protobuf:"varint,4,opt,name=DurationSeconds,proto3" json:"DurationSeconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StartOverrideRequest) Reset() {
+	*x = StartOverrideRequest{}
+	mi := &file_proto_yoconf_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartOverrideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartOverrideRequest) ProtoMessage() {}
+
+func (x *StartOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartOverrideRequest.ProtoReflect.Descriptor instead.
+func (*StartOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *StartOverrideRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *StartOverrideRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *StartOverrideRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *StartOverrideRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+type ExtendOverrideRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Project         string                 `protobuf:"bytes,1,opt,name=Project,proto3" json:"Project,omitempty"`
+	Environment     string                 `protobuf:"bytes,2,opt,name=Environment,proto3" json:"Environment,omitempty"`
+	DurationSeconds int64                  `protobuf:"varint,3,opt,name=DurationSeconds,proto3" json:"DurationSeconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ExtendOverrideRequest) Reset() {
+	*x = ExtendOverrideRequest{}
+	mi := &file_proto_yoconf_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtendOverrideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendOverrideRequest) ProtoMessage() {}
+
+func (x *ExtendOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendOverrideRequest.ProtoReflect.Descriptor instead.
+func (*ExtendOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ExtendOverrideRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *ExtendOverrideRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *ExtendOverrideRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+type OverrideRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       string                 `protobuf:"bytes,1,opt,name=Project,proto3" json:"Project,omitempty"`
+	Environment   string                 `protobuf:"bytes,2,opt,name=Environment,proto3" json:"Environment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OverrideRequest) Reset() {
+	*x = OverrideRequest{}
+	mi := &file_proto_yoconf_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OverrideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OverrideRequest) ProtoMessage() {}
+
+func (x *OverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OverrideRequest.ProtoReflect.Descriptor instead.
+func (*OverrideRequest) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *OverrideRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *OverrideRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+type Review struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reviewer      string                 `protobuf:"bytes,1,opt,name=Reviewer,proto3" json:"Reviewer,omitempty"`
+	Decision      string                 `protobuf:"bytes,2,opt,name=Decision,proto3" json:"Decision,omitempty"`
+	Comment       string                 `protobuf:"bytes,3,opt,name=Comment,proto3" json:"Comment,omitempty"`
+	Time          int64                  `protobuf:"varint,4,opt,name=Time,proto3" json:"Time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Review) Reset() {
+	*x = Review{}
+	mi := &file_proto_yoconf_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Review) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Review) ProtoMessage() {}
+
+func (x *Review) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Review.ProtoReflect.Descriptor instead.
+func (*Review) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *Review) GetReviewer() string {
+	if x != nil {
+		return x.Reviewer
+	}
+	return ""
+}
+
+func (x *Review) GetDecision() string {
+	if x != nil {
+		return x.Decision
+	}
+	return ""
+}
+
+func (x *Review) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *Review) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+type Proposal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ID            uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Project       string                 `protobuf:"bytes,2,opt,name=Project,proto3" json:"Project,omitempty"`
+	Environment   string                 `protobuf:"bytes,3,opt,name=Environment,proto3" json:"Environment,omitempty"`
+	Version       int32                  `protobuf:"varint,4,opt,name=Version,proto3" json:"Version,omitempty"`
+	Author        string                 `protobuf:"bytes,5,opt,name=Author,proto3" json:"Author,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=Status,proto3" json:"Status,omitempty"`
+	Required      int32                  `protobuf:"varint,7,opt,name=Required,proto3" json:"Required,omitempty"`
+	Reviews       []*Review              `protobuf:"bytes,8,rep,name=Reviews,proto3" json:"Reviews,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,9,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Proposal) Reset() {
+	*x = Proposal{}
+	mi := &file_proto_yoconf_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Proposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Proposal) ProtoMessage() {}
+
+func (x *Proposal) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Proposal.ProtoReflect.Descriptor instead.
+func (*Proposal) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *Proposal) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Proposal) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *Proposal) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *Proposal) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Proposal) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *Proposal) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Proposal) GetRequired() int32 {
+	if x != nil {
+		return x.Required
+	}
+	return 0
+}
+
+func (x *Proposal) GetReviews() []*Review {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+func (x *Proposal) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ID            uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Comment       string                 `protobuf:"bytes,2,opt,name=Comment,proto3" json:"Comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewRequest) Reset() {
+	*x = ReviewRequest{}
+	mi := &file_proto_yoconf_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewRequest) ProtoMessage() {}
+
+func (x *ReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewRequest.ProtoReflect.Descriptor instead.
+func (*ReviewRequest) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ReviewRequest) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *ReviewRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type ListProposalsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       string                 `protobuf:"bytes,1,opt,name=Project,proto3" json:"Project,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=Status,proto3" json:"Status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProposalsRequest) Reset() {
+	*x = ListProposalsRequest{}
+	mi := &file_proto_yoconf_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProposalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProposalsRequest) ProtoMessage() {}
+
+func (x *ListProposalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProposalsRequest.ProtoReflect.Descriptor instead.
+func (*ListProposalsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListProposalsRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *ListProposalsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ProposalsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Proposals     []*Proposal            `protobuf:"bytes,1,rep,name=Proposals,proto3" json:"Proposals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProposalsResponse) Reset() {
+	*x = ProposalsResponse{}
+	mi := &file_proto_yoconf_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProposalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposalsResponse) ProtoMessage() {}
+
+func (x *ProposalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposalsResponse.ProtoReflect.Descriptor instead.
+func (*ProposalsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ProposalsResponse) GetProposals() []*Proposal {
+	if x != nil {
+		return x.Proposals
+	}
+	return nil
+}
+
+type Lock struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       string                 `protobuf:"bytes,1,opt,name=Project,proto3" json:"Project,omitempty"`
+	Owner         string                 `protobuf:"bytes,2,opt,name=Owner,proto3" json:"Owner,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,4,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Lock) Reset() {
+	*x = Lock{}
+	mi := &file_proto_yoconf_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Lock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lock) ProtoMessage() {}
+
+func (x *Lock) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Lock.ProtoReflect.Descriptor instead.
+func (*Lock) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *Lock) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *Lock) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *Lock) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Lock) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type LockRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Project         string                 `protobuf:"bytes,1,opt,name=Project,proto3" json:"Project,omitempty"`
+	Reason          string                 `protobuf:"bytes,2,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	DurationSeconds int64                  `protobuf:"varint,3,opt,name=DurationSeconds,proto3" json:"DurationSeconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *LockRequest) Reset() {
+	*x = LockRequest{}
+	mi := &file_proto_yoconf_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockRequest) ProtoMessage() {}
+
+func (x *LockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockRequest.ProtoReflect.Descriptor instead.
+func (*LockRequest) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *LockRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *LockRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *LockRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+type ProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       string                 `protobuf:"bytes,1,opt,name=Project,proto3" json:"Project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectRequest) Reset() {
+	*x = ProjectRequest{}
+	mi := &file_proto_yoconf_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectRequest) ProtoMessage() {}
+
+func (x *ProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectRequest.ProtoReflect.Descriptor instead.
+func (*ProjectRequest) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ProjectRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+type FreezeWindow struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	ID    uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	// Empty for windows that apply to every project.
+	Project         string   `protobuf:"bytes,2,opt,name=Project,proto3" json:"Project,omitempty"`
+	Name            string   `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
+	Days            []string `protobuf:"bytes,4,rep,name=Days,proto3" json:"Days,omitempty"`
+	Start           string   `protobuf:"bytes,5,opt,name=Start,proto3" json:"Start,omitempty"`
+	DurationSeconds int64    `protobuf:"varint,6,opt,name=DurationSeconds,proto3" json:"DurationSeconds,omitempty"`
+	Timezone        string   `protobuf:"bytes,7,opt,name=Timezone,proto3" json:"Timezone,omitempty"`
+	Reason          string   `protobuf:"bytes,8,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	Author          string   `protobuf:"bytes,9,opt,name=Author,proto3" json:"Author,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FreezeWindow) Reset() {
+	*x = FreezeWindow{}
+	mi := &file_proto_yoconf_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FreezeWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreezeWindow) ProtoMessage() {}
+
+func (x *FreezeWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreezeWindow.ProtoReflect.Descriptor instead.
+func (*FreezeWindow) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *FreezeWindow) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *FreezeWindow) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *FreezeWindow) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FreezeWindow) GetDays() []string {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *FreezeWindow) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *FreezeWindow) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *FreezeWindow) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *FreezeWindow) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *FreezeWindow) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+type DeleteFreezeWindowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ID            uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFreezeWindowRequest) Reset() {
+	*x = DeleteFreezeWindowRequest{}
+	mi := &file_proto_yoconf_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFreezeWindowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFreezeWindowRequest) ProtoMessage() {}
+
+func (x *DeleteFreezeWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFreezeWindowRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFreezeWindowRequest) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DeleteFreezeWindowRequest) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type FreezeWindowsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Windows       []*FreezeWindow        `protobuf:"bytes,1,rep,name=Windows,proto3" json:"Windows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FreezeWindowsResponse) Reset() {
+	*x = FreezeWindowsResponse{}
+	mi := &file_proto_yoconf_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FreezeWindowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreezeWindowsResponse) ProtoMessage() {}
+
+func (x *FreezeWindowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreezeWindowsResponse.ProtoReflect.Descriptor instead.
+func (*FreezeWindowsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *FreezeWindowsResponse) GetWindows() []*FreezeWindow {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+type EvaluateFlagsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Project     string                 `protobuf:"bytes,1,opt,name=Project,proto3" json:"Project,omitempty"`
+	Environment string                 `protobuf:"bytes,2,opt,name=Environment,proto3" json:"Environment,omitempty"`
+	// Empty evaluates every flag of the project.
+	Flags         []string          `protobuf:"bytes,3,rep,name=Flags,proto3" json:"Flags,omitempty"`
+	TargetingKey  string            `protobuf:"bytes,4,opt,name=TargetingKey,proto3" json:"TargetingKey,omitempty"`
+	Attributes    map[string]string `protobuf:"bytes,5,rep,name=Attributes,proto3" json:"Attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluateFlagsRequest) Reset() {
+	*x = EvaluateFlagsRequest{}
+	mi := &file_proto_yoconf_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluateFlagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateFlagsRequest) ProtoMessage() {}
+
+func (x *EvaluateFlagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateFlagsRequest.ProtoReflect.Descriptor instead.
+func (*EvaluateFlagsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *EvaluateFlagsRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *EvaluateFlagsRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *EvaluateFlagsRequest) GetFlags() []string {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *EvaluateFlagsRequest) GetTargetingKey() string {
+	if x != nil {
+		return x.TargetingKey
+	}
+	return ""
+}
+
+func (x *EvaluateFlagsRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type FlagResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   string                 `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	Type  string                 `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	// JSON encoded value of the resolved variant.
+	Value         string `protobuf:"bytes,3,opt,name=Value,proto3" json:"Value,omitempty"`
+	Variant       string `protobuf:"bytes,4,opt,name=Variant,proto3" json:"Variant,omitempty"`
+	Reason        string `protobuf:"bytes,5,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	ErrorCode     string `protobuf:"bytes,6,opt,name=ErrorCode,proto3" json:"ErrorCode,omitempty"`
+	ErrorMessage  string `protobuf:"bytes,7,opt,name=ErrorMessage,proto3" json:"ErrorMessage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlagResult) Reset() {
+	*x = FlagResult{}
+	mi := &file_proto_yoconf_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlagResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlagResult) ProtoMessage() {}
+
+func (x *FlagResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlagResult.ProtoReflect.Descriptor instead.
+func (*FlagResult) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *FlagResult) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *FlagResult) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *FlagResult) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *FlagResult) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
+func (x *FlagResult) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *FlagResult) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *FlagResult) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type FlagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*FlagResult          `protobuf:"bytes,1,rep,name=Results,proto3" json:"Results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlagsResponse) Reset() {
+	*x = FlagsResponse{}
+	mi := &file_proto_yoconf_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlagsResponse) ProtoMessage() {}
+
+func (x *FlagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_yoconf_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlagsResponse.ProtoReflect.Descriptor instead.
+func (*FlagsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_yoconf_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *FlagsResponse) GetResults() []*FlagResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+var File_proto_yoconf_proto protoreflect.FileDescriptor
+
+const file_proto_yoconf_proto_rawDesc = "" +
+	"\n" +
+	"\x12proto/yoconf.proto\"\x98\x04\n" +
+	"\x05Chunk\x12\x12\n" +
+	"\x04Data\x18\x01 \x01(\tR\x04Data\x12\x18\n" +
+	"\aVersion\x18\x02 \x01(\x05R\aVersion\x12\x14\n" +
+	"\x05InUse\x18\x03 \x01(\bR\x05InUse\x12\x18\n" +
+	"\aProject\x18\x04 \x01(\tR\aProject\x12\x16\n" +
+	"\x06Format\x18\x05 \x01(\tR\x06Format\x12$\n" +
+	"\rSchemaVersion\x18\x06 \x01(\x05R\rSchemaVersion\x12\x16\n" +
+	"\x06Author\x18\a \x01(\tR\x06Author\x12 \n" +
+	"\vEnvironment\x18\b \x01(\tR\vEnvironment\x12,\n" +
+	"\x11SourceEnvironment\x18\t \x01(\tR\x11SourceEnvironment\x12$\n" +
+	"\rSourceVersion\x18\n" +
+	" \x01(\x05R\rSourceVersion\x12%\n" +
+	"\x06Layers\x18\v \x03(\v2\r.LayerVersionR\x06Layers\x12\x1c\n" +
+	"\tSignature\x18\f \x01(\tR\tSignature\x12\x1e\n" +
+	"\n" +
+	"SigningKey\x18\r \x01(\tR\n" +
+	"SigningKey\x12\x1e\n" +
+	"\n" +
+	"ProposalID\x18\x0e \x01(\x04R\n" +
+	"ProposalID\x12\x12\n" +
+	"\x04Hash\x18\x0f \x01(\tR\x04Hash\x12(\n" +
+	"\x0fExpectedVersion\x18\x10 \x01(\x05R\x0fExpectedVersion\x12\"\n" +
+	"\fExpectedHash\x18\x11 \x01(\tR\fExpectedHash\"J\n" +
+	"\fLayerVersion\x12 \n" +
+	"\vEnvironment\x18\x01 \x01(\tR\vEnvironment\x12\x18\n" +
+	"\aVersion\x18\x02 \x01(\x05R\aVersion\"\xa3\x02\n" +
+	"\aProject\x12\x12\n" +
+	"\x04Name\x18\x01 \x01(\tR\x04Name\x12\x16\n" +
+	"\x06Format\x18\x02 \x01(\tR\x06Format\x12$\n" +
+	"\rSchemaVersion\x18\x03 \x01(\x05R\rSchemaVersion\x12$\n" +
+	"\rPromotionPath\x18\x04 \x03(\tR\rPromotionPath\x12\"\n" +
+	"\x06Layers\x18\x05 \x03(\v2\n" +
+	".LayerSpecR\x06Layers\x12\x1c\n" +
+	"\tProtected\x18\x06 \x01(\bR\tProtected\x12,\n" +
+	"\x11RequiredApprovals\x18\a \x01(\x05R\x11RequiredApprovals\x12\x1c\n" +
+	"\tApprovers\x18\b \x03(\tR\tApprovers\x12\x12\n" +
+	"\x04Type\x18\t \x01(\tR\x04Type\"E\n" +
+	"\tLayerSpec\x12 \n" +
+	"\vEnvironment\x18\x01 \x01(\tR\vEnvironment\x12\x16\n" +
+	"\x06Layers\x18\x02 \x03(\tR\x06Layers\"'\n" +
+	"\x11GetProjectRequest\x12\x12\n" +
+	"\x04Name\x18\x01 \x01(\tR\x04Name\" \n" +
+	"\x04Resp\x12\x18\n" +
+	"\aMessage\x18\x01 \x01(\tR\aMessage\"\xb3\x01\n" +
+	"\rRollOnRequest\x12\x18\n" +
+	"\aProject\x18\x01 \x01(\tR\aProject\x12\x18\n" +
+	"\aVersion\x18\x02 \x01(\x05R\aVersion\x12 \n" +
+	"\vEnvironment\x18\x03 \x01(\tR\vEnvironment\x12(\n" +
+	"\x0fExpectedVersion\x18\x04 \x01(\x05R\x0fExpectedVersion\x12\"\n" +
+	"\fExpectedHash\x18\x05 \x01(\tR\fExpectedHash\"e\n" +
+	"\rDeleteRequest\x12\x18\n" +
+	"\aProject\x18\x01 \x01(\tR\aProject\x12\x18\n" +
+	"\aVersion\x18\x02 \x01(\x05R\aVersion\x12 \n" +
+	"\vEnvironment\x18\x03 \x01(\tR\vEnvironment\"m\n" +
+	"\vDiffRequest\x12\x18\n" +
+	"\aProject\x18\x01 \x01(\tR\aProject\x12\x12\n" +
+	"\x04From\x18\x02 \x01(\tR\x04From\x12\x0e\n" +
+	"\x02To\x18\x03 \x01(\tR\x02To\x12 \n" +
+	"\vEnvironment\x18\x04 \x01(\tR\vEnvironment\"T\n" +
+	"\x06Change\x12\x12\n" +
+	"\x04Path\x18\x01 \x01(\tR\x04Path\x12\x12\n" +
+	"\x04Type\x18\x02 \x01(\tR\x04Type\x12\x10\n" +
+	"\x03Old\x18\x03 \x01(\tR\x03Old\x12\x10\n" +
+	"\x03New\x18\x04 \x01(\tR\x03New\"\xe7\x01\n" +
+	"\fDiffResponse\x12\x18\n" +
+	"\aProject\x18\x01 \x01(\tR\aProject\x12 \n" +
+	"\vFromVersion\x18\x02 \x01(\x05R\vFromVersion\x12\x1c\n" +
+	"\tToVersion\x18\x03 \x01(\x05R\tToVersion\x12\x18\n" +
+	"\aUnified\x18\x04 \x01(\tR\aUnified\x12\x1e\n" +
+	"\n" +
+	"Structured\x18\x05 \x01(\bR\n" +
+	"Structured\x12!\n" +
+	"\aChanges\x18\x06 \x03(\v2\a.ChangeR\aChanges\x12 \n" +
+	"\vEnvironment\x18\a \x01(\tR\vEnvironment\"P\n" +
+	"\x06Schema\x12\x18\n" +
+	"\aProject\x18\x01 \x01(\tR\aProject\x12\x18\n" +
+	"\aVersion\x18\x02 \x01(\x05R\aVersion\x12\x12\n" +
+	"\x04Data\x18\x03 \x01(\tR\x04Data\"F\n" +
+	"\x10GetSchemaRequest\x12\x18\n" +
+	"\aProject\x18\x01 \x01(\tR\aProject\x12\x18\n" +
+	"\aVersion\x18\x02 \x01(\x05R\aVersion\"S\n" +
+	"\tViolation\x12\x12\n" +
+	"\x04Path\x18\x01 \x01(\tR\x04Path\x12\x18\n" +
+	"\aKeyword\x18\x02 \x01(\tR\aKeyword\x12\x18\n" +
+	"\aMessage\x18\x03 \x01(\tR\aMessage\"N\n" +
+	"\n" +
+	"RuleResult\x12\x12\n" +
+	"\x04Rule\x18\x01 \x01(\tR\x04Rule\x12\x12\n" +
+	"\x04Mode\x18\x02 \x01(\tR\x04Mode\x12\x18\n" +
+	"\aMessage\x18\x03 \x01(\tR\aMessage\"\xc0\x01\n" +
+	"\x10ValidateResponse\x12\x14\n" +
+	"\x05Valid\x18\x01 \x01(\bR\x05Valid\x12\x18\n" +
+	"\aMessage\x18\x02 \x01(\tR\aMessage\x12*\n" +
+	"\n" +
+	"Violations\x18\x03 \x03(\v2\n" +
+	".ViolationR\n" +
+	"Violations\x12'\n" +
+	"\bFailures\x18\x04 \x03(\v2\v.RuleResultR\bFailures\x12'\n" +
+	"\bWarnings\x18\x05 \x03(\v2\v.RuleResultR\bWarnings\"\x82\x01\n" +
+	"\x04Rule\x12\x18\n" +
+	"\aProject\x18\x01 \x01(\tR\aProject\x12\x12\n" +
+	"\x04Name\x18\x02 \x01(\tR\x04Name\x12\x1e\n" +
+	"\n" +
+	"Expression\x18\x03 \x01(\tR\n" +
+	"Expression\x12\x18\n" +
+	"\aMessage\x18\x04 \x01(\tR\aMessage\x12\x12\n" +
+	"\x04Mode\x18\x05 \x01(\tR\x04Mode\"A\n" +
+	"\x11DeleteRuleRequest\x12\x18\n" +
+	"\aProject\x18\x01 \x01(\tR\aProject\x12\x12\n" +
+	"\x04Name\x18\x02 \x01(\tR\x04Name\",\n" +
+	"\x10ListRulesRequest\x12\x18\n" +
+	"\aProject\x18\x01 \x01(\tR\aProject\",\n" +
+	"\rRulesResponse\x12\x1b\n" +
+	"\x05Rules\x18\x01 \x03(\v2\x05.RuleR\x05Rules\"3\n" +
+	"\x17ListEnvironmentsRequest\x12\x18\n" +
+	"\aProject\x18\x01 \x01(\tR\aProject\"T\n" +
+	"\x14EnvironmentsResponse\x12\x18\n" +
+	"\aProject\x18\x01 \x01(\tR\aProject\x12\"\n" +
+	"\fEnvironments\x18\x02 \x03(\tR\fEnvironments\"\xb8\x01\n" +
+	"\x0ePromoteRequest\x12\x18\n" +
+	"\aProject\x18\x01 \x01(\tR\aProject\x12,\n" +
+	"\x11SourceEnvironment\x18\x02 \x01(\tR\x11SourceEnvironment\x12\x18\n" +
+	"\aVersion\x18\x03 \x01(\x05R\aVersion\x12,\n" +
+	"\x11TargetEnvironment\x18\x04 \x01(\tR\x11TargetEnvironment\x12\x16\n" +
+	"\x06Author\x18\x05 \x01(\tR\x06Author\"\xae\x01\n" +
+	"\fPatchRequest\x12\x18\n" +
+	"\aProject\x18\x01 \x01(\tR\aProject\x12 \n" +
+	"\vEnvironment\x18\x02 \x01(\tR\vEnvironment\x12 \n" +
+	"\vBaseVersion\x18\x03 \x01(\x05R\vBaseVersion\x12\x12\n" +
+	"\x04Type\x18\x04 \x01(\tR\x04Type\x12\x14\n" +
+	"\x05Patch\x18\x05 \x01(\tR\x05Patch\x12\x16\n" +
+	"\x06Author\x18\x06 \x01(\tR\x06Author\"\xc2\x01\n" +
+	"\n" +
+	"Dependency\x12\x18\n" +
+	"\aProject\x18\x01 \x01(\tR\aProject\x12 \n" +
+	"\vEnvironment\x18\x02 \x01(\tR\vEnvironment\x12$\n" +
+	"\rTargetProject\x18\x03 \x01(\tR\rTargetProject\x12,\n" +
+	"\x11TargetEnvironment\x18\x04 \x01(\tR\x11TargetEnvironment\x12$\n" +
+	"\rTargetVersion\x18\x05 \x01(\x05R\rTargetVersion\"U\n" +
+	"\x17ListDependenciesRequest\x12\x18\n" +
+	"\aProject\x18\x01 \x01(\tR\aProject\x12 \n" +
+	"\vEnvironment\x18\x02 \x01(\tR\vEnvironment\"t\n" +
+	"\x14DependenciesResponse\x12/\n" +
+	"\fDependencies\x18\x01 \x03(\v2\v.DependencyR\fDependencies\x12+\n" +
+	"\n" +
+	"Dependents\x18\x02 \x03(\v2\v.DependencyR\n" +
+	"Dependents\"\x88\x02\n" +
+	"\x10GetConfigRequest\x12\x18\n" +
+	"\aProject\x18\x01 \x01(\tR\aProject\x12 \n" +
+	"\vEnvironment\x18\x02 \x01(\tR\vEnvironment\x12\x1a\n" +
+	"\bClientID\x18\x03 \x01(\tR\bClientID\x125\n" +
+	"\x06Labels\x18\x04 \x03(\v2\x1d.GetConfigRequest.LabelsEntryR\x06Labels\x12\x12\n" +
+	"\x04Path\x18\x05 \x01(\tR\x04Path\x12\x16\n" +
+	"\x06Format\x18\x06 \x01(\tR\x06Format\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"q\n" +
+	"\tPublicKey\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\tR\x02ID\x12\x1c\n" +
+	"\tAlgorithm\x18\x02 \x01(\tR\tAlgorithm\x12\x1c\n" +
+	"\tPublicKey\x18\x03 \x01(\tR\tPublicKey\x12\x18\n" +
+	"\aCurrent\x18\x04 \x01(\bR\aCurrent\"\x18\n" +
+	"\x16ListSigningKeysRequest\"5\n" +
+	"\x13SigningKeysResponse\x12\x1e\n" +
+	"\x04Keys\x18\x01 \x03(\v2\n" +
+	".PublicKeyR\x04Keys\"7\n" +
+	"\vRolloutStep\x12\x18\n" +
+	"\aPercent\x18\x01 \x01(\x05R\aPercent\x12\x0e\n" +
+	"\x02At\x18\x02 \x01(\x03R\x02At\"\x9d\x01\n" +
+	"\aRollout\x12\x18\n" +
+	"\aProject\x18\x01 \x01(\tR\aProject\x12 \n" +
+	"\vEnvironment\x18\x02 \x01(\tR\vEnvironment\x12\x18\n" +
+	"\aVersion\x18\x03 \x01(\x05R\aVersion\x12\x18\n" +
+	"\aPercent\x18\x04 \x01(\x05R\aPercent\x12\"\n" +
+	"\x05Steps\x18\x05 \x03(\v2\f.RolloutStepR\x05Steps\"L\n" +
+	"\x0eRolloutRequest\x12\x18\n" +
+	"\aProject\x18\x01 \x01(\tR\aProject\x12 \n" +
+	"\vEnvironment\x18\x02 \x01(\tR\vEnvironment\"m\n" +
+	"\x15RolloutPercentRequest\x12\x18\n" +
+	"\aProject\x18\x01 \x01(\tR\aProject\x12 \n" +
+	"\vEnvironment\x18\x02 \x01(\tR\vEnvironment\x12\x18\n" +
+	"\aPercent\x18\x03 \x01(\x05R\aPercent\"V\n" +
+	"\n" +
+	"TargetRule\x12\x12\n" +
+	"\x04Name\x18\x01 \x01(\tR\x04Name\x12\x1a\n" +
+	"\bSelector\x18\x02 \x01(\tR\bSelector\x12\x18\n" +
+	"\aVersion\x18\x03 \x01(\x05R\aVersion\"\x92\x01\n" +
+	"\tTargeting\x12\x18\n" +
+	"\aProject\x18\x01 \x01(\tR\aProject\x12 \n" +
+	"\vEnvironment\x18\x02 \x01(\tR\vEnvironment\x12!\n" +
+	"\x05Rules\x18\x03 \x03(\v2\v.TargetRuleR\x05Rules\x12&\n" +
+	"\x0eDefaultVersion\x18\x04 \x01(\x05R\x0eDefaultVersion\"Q\n" +
+	"\x13GetTargetingRequest\x12\x18\n" +
+	"\aProject\x18\x01 \x01(\tR\aProject\x12 \n" +
+	"\vEnvironment\x18\x02 \x01(\tR\vEnvironment\"\xd0\x01\n" +
+	"\x18EvaluateTargetingRequest\x12\x18\n" +
+	"\aProject\x18\x01 \x01(\tR\aProject\x12 \n" +
+	"\vEnvironment\x18\x02 \x01(\tR\vEnvironment\x12=\n" +
+	"\x06Labels\x18\x03 \x03(\v2%.EvaluateTargetingRequest.LabelsEntryR\x06Labels\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"Y\n" +
+	"\x0fTargetingResult\x12\x12\n" +
+	"\x04Rule\x18\x01 \x01(\tR\x04Rule\x12\x18\n" +
+	"\aVersion\x18\x02 \x01(\x05R\aVersion\x12\x18\n" +
+	"\aDefault\x18\x03 \x01(\bR\aDefault\"\xc6\x01\n" +
+	"\bSchedule\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x04R\x02ID\x12\x18\n" +
+	"\aProject\x18\x02 \x01(\tR\aProject\x12 \n" +
+	"\vEnvironment\x18\x03 \x01(\tR\vEnvironment\x12\x18\n" +
+	"\aVersion\x18\x04 \x01(\x05R\aVersion\x12\x0e\n" +
+	"\x02At\x18\x05 \x01(\x03R\x02At\x12\x16\n" +
+	"\x06Author\x18\x06 \x01(\tR\x06Author\x12\x16\n" +
+	"\x06Status\x18\a \x01(\tR\x06Status\x12\x14\n" +
+	"\x05Error\x18\b \x01(\tR\x05Error\"R\n" +
+	"\x14ListSchedulesRequest\x12\x18\n" +
+	"\aProject\x18\x01 \x01(\tR\aProject\x12 \n" +
+	"\vEnvironment\x18\x02 \x01(\tR\vEnvironment\"<\n" +
+	"\x11SchedulesResponse\x12'\n" +
+	"\tSchedules\x18\x01 \x03(\v2\t.ScheduleR\tSchedules\"'\n" +
+	"\x15CancelScheduleRequest\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x04R\x02ID\"\xcc\x01\n" +
+	"\n" +
+	"AuditEntry\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x04R\x02ID\x12\x12\n" +
+	"\x04Time\x18\x02 \x01(\x03R\x04Time\x12\x14\n" +
+	"\x05Actor\x18\x03 \x01(\tR\x05Actor\x12\x16\n" +
+	"\x06Action\x18\x04 \x01(\tR\x06Action\x12\x18\n" +
+	"\aProject\x18\x05 \x01(\tR\aProject\x12 \n" +
+	"\vEnvironment\x18\x06 \x01(\tR\vEnvironment\x12\x18\n" +
+	"\aVersion\x18\a \x01(\x05R\aVersion\x12\x16\n" +
+	"\x06Detail\x18\b \x01(\tR\x06Detail\"B\n" +
+	"\x10ListAuditRequest\x12\x18\n" +
+	"\aProject\x18\x01 \x01(\tR\aProject\x12\x14\n" +
+	"\x05Limit\x18\x02 \x01(\x05R\x05Limit\"6\n" +
+	"\rAuditResponse\x12%\n" +
+	"\aEntries\x18\x01 \x03(\v2\v.AuditEntryR\aEntries\"\xc0\x01\n" +
+	"\bOverride\x12\x18\n" +
+	"\aProject\x18\x01 \x01(\tR\aProject\x12 \n" +
+	"\vEnvironment\x18\x02 \x01(\tR\vEnvironment\x12\x18\n" +
+	"\aVersion\x18\x03 \x01(\x05R\aVersion\x12(\n" +
+	"\x0fPreviousVersion\x18\x04 \x01(\x05R\x0fPreviousVersion\x12\x1c\n" +
+	"\tExpiresAt\x18\x05 \x01(\x03R\tExpiresAt\x12\x16\n" +
+	"\x06Author\x18\x06 \x01(\tR\x06Author\"\x96\x01\n" +
+	"\x14StartOverrideRequest\x12\x18\n" +
+	"\aProject\x18\x01 \x01(\tR\aProject\x12 \n" +
+	"\vEnvironment\x18\x02 \x01(\tR\vEnvironment\x12\x18\n" +
+	"\aVersion\x18\x03 \x01(\x05R\aVersion\x12(\n" +
+	"\x0fDurationSeconds\x18\x04 \x01(\x03R\x0fDurationSeconds\"}\n" +
+	"\x15ExtendOverrideRequest\x12\x18\n" +
+	"\aProject\x18\x01 \x01(\tR\aProject\x12 \n" +
+	"\vEnvironment\x18\x02 \x01(\tR\vEnvironment\x12(\n" +
+	"\x0fDurationSeconds\x18\x03 \x01(\x03R\x0fDurationSeconds\"M\n" +
+	"\x0fOverrideRequest\x12\x18\n" +
+	"\aProject\x18\x01 \x01(\tR\aProject\x12 \n" +
+	"\vEnvironment\x18\x02 \x01(\tR\vEnvironment\"n\n" +
+	"\x06Review\x12\x1a\n" +
+	"\bReviewer\x18\x01 \x01(\tR\bReviewer\x12\x1a\n" +
+	"\bDecision\x18\x02 \x01(\tR\bDecision\x12\x18\n" +
+	"\aComment\x18\x03 \x01(\tR\aComment\x12\x12\n" +
+	"\x04Time\x18\x04 \x01(\x03R\x04Time\"\xfd\x01\n" +
+	"\bProposal\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x04R\x02ID\x12\x18\n" +
+	"\aProject\x18\x02 \x01(\tR\aProject\x12 \n" +
+	"\vEnvironment\x18\x03 \x01(\tR\vEnvironment\x12\x18\n" +
+	"\aVersion\x18\x04 \x01(\x05R\aVersion\x12\x16\n" +
+	"\x06Author\x18\x05 \x01(\tR\x06Author\x12\x16\n" +
+	"\x06Status\x18\x06 \x01(\tR\x06Status\x12\x1a\n" +
+	"\bRequired\x18\a \x01(\x05R\bRequired\x12!\n" +
+	"\aReviews\x18\b \x03(\v2\a.ReviewR\aReviews\x12\x1c\n" +
+	"\tCreatedAt\x18\t \x01(\x03R\tCreatedAt\"9\n" +
+	"\rReviewRequest\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x04R\x02ID\x12\x18\n" +
+	"\aComment\x18\x02 \x01(\tR\aComment\"H\n" +
+	"\x14ListProposalsRequest\x12\x18\n" +
+	"\aProject\x18\x01 \x01(\tR\aProject\x12\x16\n" +
+	"\x06Status\x18\x02 \x01(\tR\x06Status\"<\n" +
+	"\x11ProposalsResponse\x12'\n" +
+	"\tProposals\x18\x01 \x03(\v2\t.ProposalR\tProposals\"l\n" +
+	"\x04Lock\x12\x18\n" +
+	"\aProject\x18\x01 \x01(\tR\aProject\x12\x14\n" +
+	"\x05Owner\x18\x02 \x01(\tR\x05Owner\x12\x16\n" +
+	"\x06Reason\x18\x03 \x01(\tR\x06Reason\x12\x1c\n" +
+	"\tExpiresAt\x18\x04 \x01(\x03R\tExpiresAt\"i\n" +
+	"\vLockRequest\x12\x18\n" +
+	"\aProject\x18\x01 \x01(\tR\aProject\x12\x16\n" +
+	"\x06Reason\x18\x02 \x01(\tR\x06Reason\x12(\n" +
+	"\x0fDurationSeconds\x18\x03 \x01(\x03R\x0fDurationSeconds\"*\n" +
+	"\x0eProjectRequest\x12\x18\n" +
+	"\aProject\x18\x01 \x01(\tR\aProject\"\xec\x01\n" +
+	"\fFreezeWindow\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x04R\x02ID\x12\x18\n" +
+	"\aProject\x18\x02 \x01(\tR\aProject\x12\x12\n" +
+	"\x04Name\x18\x03 \x01(\tR\x04Name\x12\x12\n" +
+	"\x04Days\x18\x04 \x03(\tR\x04Days\x12\x14\n" +
+	"\x05Start\x18\x05 \x01(\tR\x05Start\x12(\n" +
+	"\x0fDurationSeconds\x18\x06 \x01(\x03R\x0fDurationSeconds\x12\x1a\n" +
+	"\bTimezone\x18\a \x01(\tR\bTimezone\x12\x16\n" +
+	"\x06Reason\x18\b \x01(\tR\x06Reason\x12\x16\n" +
+	"\x06Author\x18\t \x01(\tR\x06Author\"+\n" +
+	"\x19DeleteFreezeWindowRequest\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x04R\x02ID\"@\n" +
+	"\x15FreezeWindowsResponse\x12'\n" +
+	"\aWindows\x18\x01 \x03(\v2\r.FreezeWindowR\aWindows\"\x92\x02\n" +
+	"\x14EvaluateFlagsRequest\x12\x18\n" +
+	"\aProject\x18\x01 \x01(\tR\aProject\x12 \n" +
+	"\vEnvironment\x18\x02 \x01(\tR\vEnvironment\x12\x14\n" +
+	"\x05Flags\x18\x03 \x03(\tR\x05Flags\x12\"\n" +
+	"\fTargetingKey\x18\x04 \x01(\tR\fTargetingKey\x12E\n" +
+	"\n" +
+	"Attributes\x18\x05 \x03(\v2%.EvaluateFlagsRequest.AttributesEntryR\n" +
+	"Attributes\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xbc\x01\n" +
+	"\n" +
+	"FlagResult\x12\x10\n" +
+	"\x03Key\x18\x01 \x01(\tR\x03Key\x12\x12\n" +
+	"\x04Type\x18\x02 \x01(\tR\x04Type\x12\x14\n" +
+	"\x05Value\x18\x03 \x01(\tR\x05Value\x12\x18\n" +
+	"\aVariant\x18\x04 \x01(\tR\aVariant\x12\x16\n" +
+	"\x06Reason\x18\x05 \x01(\tR\x06Reason\x12\x1c\n" +
+	"\tErrorCode\x18\x06 \x01(\tR\tErrorCode\x12\"\n" +
+	"\fErrorMessage\x18\a \x01(\tR\fErrorMessage\"6\n" +
+	"\rFlagsResponse\x12%\n" +
+	"\aResults\x18\x01 \x03(\v2\v.FlagResultR\aResults2\xd0\x0f\n" +
+	"\x06YoConf\x12\x1c\n" +
+	"\vCreateChunk\x12\x06.Chunk\x1a\x05.Resp\x12\x1f\n" +
+	"\x06RollOn\x12\x0e.RollOnRequest\x1a\x05.Resp\x12$\n" +
+	"\vDeleteChunk\x12\x0e.DeleteRequest\x1a\x05.Resp\x12#\n" +
+	"\x04Diff\x12\f.DiffRequest\x1a\r.DiffResponse\x12\x1d\n" +
+	"\n" +
+	"SetProject\x12\b.Project\x1a\x05.Resp\x12*\n" +
+	"\n" +
+	"GetProject\x12\x12.GetProjectRequest\x1a\b.Project\x12\x1d\n" +
+	"\tSetSchema\x12\a.Schema\x1a\a.Schema\x12'\n" +
+	"\tGetSchema\x12\x11.GetSchemaRequest\x1a\a.Schema\x12*\n" +
+	"\rValidateChunk\x12\x06.Chunk\x1a\x11.ValidateResponse\x12\x17\n" +
+	"\aSetRule\x12\x05.Rule\x1a\x05.Resp\x12'\n" +
+	"\n" +
+	"DeleteRule\x12\x12.DeleteRuleRequest\x1a\x05.Resp\x12.\n" +
+	"\tListRules\x12\x11.ListRulesRequest\x1a\x0e.RulesResponse\x12C\n" +
+	"\x10ListEnvironments\x12\x18.ListEnvironmentsRequest\x1a\x15.EnvironmentsResponse\x12\"\n" +
+	"\aPromote\x12\x0f.PromoteRequest\x1a\x06.Chunk\x12\x1e\n" +
+	"\x05Patch\x12\r.PatchRequest\x1a\x06.Chunk\x12C\n" +
+	"\x10ListDependencies\x12\x18.ListDependenciesRequest\x1a\x15.DependenciesResponse\x12&\n" +
+	"\tGetConfig\x12\x11.GetConfigRequest\x1a\x06.Chunk\x12@\n" +
+	"\x0fListSigningKeys\x12\x17.ListSigningKeysRequest\x1a\x14.SigningKeysResponse\x12\"\n" +
+	"\fStartRollout\x12\b.Rollout\x1a\b.Rollout\x12'\n" +
+	"\n" +
+	"GetRollout\x12\x0f.RolloutRequest\x1a\b.Rollout\x125\n" +
+	"\x11SetRolloutPercent\x12\x16.RolloutPercentRequest\x1a\b.Rollout\x12(\n" +
+	"\x0ePromoteRollout\x12\x0f.RolloutRequest\x1a\x05.Resp\x12&\n" +
+	"\fAbortRollout\x12\x0f.RolloutRequest\x1a\x05.Resp\x12!\n" +
+	"\fSetTargeting\x12\n" +
+	".Targeting\x1a\x05.Resp\x120\n" +
+	"\fGetTargeting\x12\x14.GetTargetingRequest\x1a\n" +
+	".Targeting\x12@\n" +
+	"\x11EvaluateTargeting\x12\x19.EvaluateTargetingRequest\x1a\x10.TargetingResult\x12&\n" +
+	"\x0eScheduleRollOn\x12\t.Schedule\x1a\t.Schedule\x12:\n" +
+	"\rListSchedules\x12\x15.ListSchedulesRequest\x1a\x12.SchedulesResponse\x12/\n" +
+	"\x0eCancelSchedule\x12\x16.CancelScheduleRequest\x1a\x05.Resp\x12.\n" +
+	"\tListAudit\x12\x11.ListAuditRequest\x1a\x0e.AuditResponse\x121\n" +
+	"\rStartOverride\x12\x15.StartOverrideRequest\x1a\t.Override\x123\n" +
+	"\x0eExtendOverride\x12\x16.ExtendOverrideRequest\x1a\t.Override\x12&\n" +
+	"\vEndOverride\x12\x10.OverrideRequest\x1a\x05.Resp\x12*\n" +
+	"\vGetOverride\x12\x10.OverrideRequest\x1a\t.Override\x12,\n" +
+	"\x0fApproveProposal\x12\x0e.ReviewRequest\x1a\t.Proposal\x12+\n" +
+	"\x0eRejectProposal\x12\x0e.ReviewRequest\x1a\t.Proposal\x12:\n" +
+	"\rListProposals\x12\x15.ListProposalsRequest\x1a\x12.ProposalsResponse\x12\"\n" +
+	"\vLockProject\x12\f.LockRequest\x1a\x05.Lock\x12'\n" +
+	"\rUnlockProject\x12\x0f.ProjectRequest\x1a\x05.Resp\x12!\n" +
+	"\aGetLock\x12\x0f.ProjectRequest\x1a\x05.Lock\x12/\n" +
+	"\x0fSetFreezeWindow\x12\r.FreezeWindow\x1a\r.FreezeWindow\x127\n" +
+	"\x12DeleteFreezeWindow\x12\x1a.DeleteFreezeWindowRequest\x1a\x05.Resp\x12<\n" +
+	"\x11ListFreezeWindows\x12\x0f.ProjectRequest\x1a\x16.FreezeWindowsResponse\x126\n" +
+	"\rEvaluateFlags\x12\x15.EvaluateFlagsRequest\x1a\x0e.FlagsResponseB\x06Z\x04./pbb\x06proto3"
 
 var (
 	file_proto_yoconf_proto_rawDescOnce sync.Once
@@ -272,25 +4597,192 @@ func file_proto_yoconf_proto_rawDescGZIP() []byte {
 	return file_proto_yoconf_proto_rawDescData
 }
 
-var file_proto_yoconf_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_proto_yoconf_proto_msgTypes = make([]protoimpl.MessageInfo, 68)
 var file_proto_yoconf_proto_goTypes = []any{
-	(*Chunk)(nil),         // 0: Chunk
-	(*Resp)(nil),          // 1: Resp
-	(*RollOnRequest)(nil), // 2: RollOnRequest
-	(*DeleteRequest)(nil), // 3: DeleteRequest
+	(*Chunk)(nil),                     // 0: Chunk
+	(*LayerVersion)(nil),              // 1: LayerVersion
+	(*Project)(nil),                   // 2: Project
+	(*LayerSpec)(nil),                 // 3: LayerSpec
+	(*GetProjectRequest)(nil),         // 4: GetProjectRequest
+	(*Resp)(nil),                      // 5: Resp
+	(*RollOnRequest)(nil),             // 6: RollOnRequest
+	(*DeleteRequest)(nil),             // 7: DeleteRequest
+	(*DiffRequest)(nil),               // 8: DiffRequest
+	(*Change)(nil),                    // 9: Change
+	(*DiffResponse)(nil),              // 10: DiffResponse
+	(*Schema)(nil),                    // 11: Schema
+	(*GetSchemaRequest)(nil),          // 12: GetSchemaRequest
+	(*Violation)(nil),                 // 13: Violation
+	(*RuleResult)(nil),                // 14: RuleResult
+	(*ValidateResponse)(nil),          // 15: ValidateResponse
+	(*Rule)(nil),                      // 16: Rule
+	(*DeleteRuleRequest)(nil),         // 17: DeleteRuleRequest
+	(*ListRulesRequest)(nil),          // 18: ListRulesRequest
+	(*RulesResponse)(nil),             // 19: RulesResponse
+	(*ListEnvironmentsRequest)(nil),   // 20: ListEnvironmentsRequest
+	(*EnvironmentsResponse)(nil),      // 21: EnvironmentsResponse
+	(*PromoteRequest)(nil),            // 22: PromoteRequest
+	(*PatchRequest)(nil),              // 23: PatchRequest
+	(*Dependency)(nil),                // 24: Dependency
+	(*ListDependenciesRequest)(nil),   // 25: ListDependenciesRequest
+	(*DependenciesResponse)(nil),      // 26: DependenciesResponse
+	(*GetConfigRequest)(nil),          // 27: GetConfigRequest
+	(*PublicKey)(nil),                 // 28: PublicKey
+	(*ListSigningKeysRequest)(nil),    // 29: ListSigningKeysRequest
+	(*SigningKeysResponse)(nil),       // 30: SigningKeysResponse
+	(*RolloutStep)(nil),               // 31: RolloutStep
+	(*Rollout)(nil),                   // 32: Rollout
+	(*RolloutRequest)(nil),            // 33: RolloutRequest
+	(*RolloutPercentRequest)(nil),     // 34: RolloutPercentRequest
+	(*TargetRule)(nil),                // 35: TargetRule
+	(*Targeting)(nil),                 // 36: Targeting
+	(*GetTargetingRequest)(nil),       // 37: GetTargetingRequest
+	(*EvaluateTargetingRequest)(nil),  // 38: EvaluateTargetingRequest
+	(*TargetingResult)(nil),           // 39: TargetingResult
+	(*Schedule)(nil),                  // 40: Schedule
+	(*ListSchedulesRequest)(nil),      // 41: ListSchedulesRequest
+	(*SchedulesResponse)(nil),         // 42: SchedulesResponse
+	(*CancelScheduleRequest)(nil),     // 43: CancelScheduleRequest
+	(*AuditEntry)(nil),                // 44: AuditEntry
+	(*ListAuditRequest)(nil),          // 45: ListAuditRequest
+	(*AuditResponse)(nil),             // 46: AuditResponse
+	(*Override)(nil),                  // 47: Override
+	(*StartOverrideRequest)(nil),      // 48: StartOverrideRequest
+	(*ExtendOverrideRequest)(nil),     // 49: ExtendOverrideRequest
+	(*OverrideRequest)(nil),           // 50: OverrideRequest
+	(*Review)(nil),                    // 51: Review
+	(*Proposal)(nil),                  // 52: Proposal
+	(*ReviewRequest)(nil),             // 53: ReviewRequest
+	(*ListProposalsRequest)(nil),      // 54: ListProposalsRequest
+	(*ProposalsResponse)(nil),         // 55: ProposalsResponse
+	(*Lock)(nil),                      // 56: Lock
+	(*LockRequest)(nil),               // 57: LockRequest
+	(*ProjectRequest)(nil),            // 58: ProjectRequest
+	(*FreezeWindow)(nil),              // 59: FreezeWindow
+	(*DeleteFreezeWindowRequest)(nil), // 60: DeleteFreezeWindowRequest
+	(*FreezeWindowsResponse)(nil),     // 61: FreezeWindowsResponse
+	(*EvaluateFlagsRequest)(nil),      // 62: EvaluateFlagsRequest
+	(*FlagResult)(nil),                // 63: FlagResult
+	(*FlagsResponse)(nil),             // 64: FlagsResponse
+	nil,                               // 65: GetConfigRequest.LabelsEntry
+	nil,                               // 66: EvaluateTargetingRequest.LabelsEntry
+	nil,                               // 67: EvaluateFlagsRequest.AttributesEntry
 }
 var file_proto_yoconf_proto_depIdxs = []int32{
-	0, // 0: YoConf.CreateChunk:input_type -> Chunk
-	2, // 1: YoConf.RollOn:input_type -> RollOnRequest
-	3, // 2: YoConf.DeleteChunk:input_type -> DeleteRequest
-	1, // 3: YoConf.CreateChunk:output_type -> Resp
-	1, // 4: YoConf.RollOn:output_type -> Resp
-	1, // 5: YoConf.DeleteChunk:output_type -> Resp
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1,  // 0: Chunk.Layers:type_name -> LayerVersion
+	3,  // 1: Project.Layers:type_name -> LayerSpec
+	9,  // 2: DiffResponse.Changes:type_name -> Change
+	13, // 3: ValidateResponse.Violations:type_name -> Violation
+	14, // 4: ValidateResponse.Failures:type_name -> RuleResult
+	14, // 5: ValidateResponse.Warnings:type_name -> RuleResult
+	16, // 6: RulesResponse.Rules:type_name -> Rule
+	24, // 7: DependenciesResponse.Dependencies:type_name -> Dependency
+	24, // 8: DependenciesResponse.Dependents:type_name -> Dependency
+	65, // 9: GetConfigRequest.Labels:type_name -> GetConfigRequest.LabelsEntry
+	28, // 10: SigningKeysResponse.Keys:type_name -> PublicKey
+	31, // 11: Rollout.Steps:type_name -> RolloutStep
+	35, // 12: Targeting.Rules:type_name -> TargetRule
+	66, // 13: EvaluateTargetingRequest.Labels:type_name -> EvaluateTargetingRequest.LabelsEntry
+	40, // 14: SchedulesResponse.Schedules:type_name -> Schedule
+	44, // 15: AuditResponse.Entries:type_name -> AuditEntry
+	51, // 16: Proposal.Reviews:type_name -> Review
+	52, // 17: ProposalsResponse.Proposals:type_name -> Proposal
+	59, // 18: FreezeWindowsResponse.Windows:type_name -> FreezeWindow
+	67, // 19: EvaluateFlagsRequest.Attributes:type_name -> EvaluateFlagsRequest.AttributesEntry
+	63, // 20: FlagsResponse.Results:type_name -> FlagResult
+	0,  // 21: YoConf.CreateChunk:input_type -> Chunk
+	6,  // 22: YoConf.RollOn:input_type -> RollOnRequest
+	7,  // 23: YoConf.DeleteChunk:input_type -> DeleteRequest
+	8,  // 24: YoConf.Diff:input_type -> DiffRequest
+	2,  // 25: YoConf.SetProject:input_type -> Project
+	4,  // 26: YoConf.GetProject:input_type -> GetProjectRequest
+	11, // 27: YoConf.SetSchema:input_type -> Schema
+	12, // 28: YoConf.GetSchema:input_type -> GetSchemaRequest
+	0,  // 29: YoConf.ValidateChunk:input_type -> Chunk
+	16, // 30: YoConf.SetRule:input_type -> Rule
+	17, // 31: YoConf.DeleteRule:input_type -> DeleteRuleRequest
+	18, // 32: YoConf.ListRules:input_type -> ListRulesRequest
+	20, // 33: YoConf.ListEnvironments:input_type -> ListEnvironmentsRequest
+	22, // 34: YoConf.Promote:input_type -> PromoteRequest
+	23, // 35: YoConf.Patch:input_type -> PatchRequest
+	25, // 36: YoConf.ListDependencies:input_type -> ListDependenciesRequest
+	27, // 37: YoConf.GetConfig:input_type -> GetConfigRequest
+	29, // 38: YoConf.ListSigningKeys:input_type -> ListSigningKeysRequest
+	32, // 39: YoConf.StartRollout:input_type -> Rollout
+	33, // 40: YoConf.GetRollout:input_type -> RolloutRequest
+	34, // 41: YoConf.SetRolloutPercent:input_type -> RolloutPercentRequest
+	33, // 42: YoConf.PromoteRollout:input_type -> RolloutRequest
+	33, // 43: YoConf.AbortRollout:input_type -> RolloutRequest
+	36, // 44: YoConf.SetTargeting:input_type -> Targeting
+	37, // 45: YoConf.GetTargeting:input_type -> GetTargetingRequest
+	38, // 46: YoConf.EvaluateTargeting:input_type -> EvaluateTargetingRequest
+	40, // 47: YoConf.ScheduleRollOn:input_type -> Schedule
+	41, // 48: YoConf.ListSchedules:input_type -> ListSchedulesRequest
+	43, // 49: YoConf.CancelSchedule:input_type -> CancelScheduleRequest
+	45, // 50: YoConf.ListAudit:input_type -> ListAuditRequest
+	48, // 51: YoConf.StartOverride:input_type -> StartOverrideRequest
+	49, // 52: YoConf.ExtendOverride:input_type -> ExtendOverrideRequest
+	50, // 53: YoConf.EndOverride:input_type -> OverrideRequest
+	50, // 54: YoConf.GetOverride:input_type -> OverrideRequest
+	53, // 55: YoConf.ApproveProposal:input_type -> ReviewRequest
+	53, // 56: YoConf.RejectProposal:input_type -> ReviewRequest
+	54, // 57: YoConf.ListProposals:input_type -> ListProposalsRequest
+	57, // 58: YoConf.LockProject:input_type -> LockRequest
+	58, // 59: YoConf.UnlockProject:input_type -> ProjectRequest
+	58, // 60: YoConf.GetLock:input_type -> ProjectRequest
+	59, // 61: YoConf.SetFreezeWindow:input_type -> FreezeWindow
+	60, // 62: YoConf.DeleteFreezeWindow:input_type -> DeleteFreezeWindowRequest
+	58, // 63: YoConf.ListFreezeWindows:input_type -> ProjectRequest
+	62, // 64: YoConf.EvaluateFlags:input_type -> EvaluateFlagsRequest
+	5,  // 65: YoConf.CreateChunk:output_type -> Resp
+	5,  // 66: YoConf.RollOn:output_type -> Resp
+	5,  // 67: YoConf.DeleteChunk:output_type -> Resp
+	10, // 68: YoConf.Diff:output_type -> DiffResponse
+	5,  // 69: YoConf.SetProject:output_type -> Resp
+	2,  // 70: YoConf.GetProject:output_type -> Project
+	11, // 71: YoConf.SetSchema:output_type -> Schema
+	11, // 72: YoConf.GetSchema:output_type -> Schema
+	15, // 73: YoConf.ValidateChunk:output_type -> ValidateResponse
+	5,  // 74: YoConf.SetRule:output_type -> Resp
+	5,  // 75: YoConf.DeleteRule:output_type -> Resp
+	19, // 76: YoConf.ListRules:output_type -> RulesResponse
+	21, // 77: YoConf.ListEnvironments:output_type -> EnvironmentsResponse
+	0,  // 78: YoConf.Promote:output_type -> Chunk
+	0,  // 79: YoConf.Patch:output_type -> Chunk
+	26, // 80: YoConf.ListDependencies:output_type -> DependenciesResponse
+	0,  // 81: YoConf.GetConfig:output_type -> Chunk
+	30, // 82: YoConf.ListSigningKeys:output_type -> SigningKeysResponse
+	32, // 83: YoConf.StartRollout:output_type -> Rollout
+	32, // 84: YoConf.GetRollout:output_type -> Rollout
+	32, // 85: YoConf.SetRolloutPercent:output_type -> Rollout
+	5,  // 86: YoConf.PromoteRollout:output_type -> Resp
+	5,  // 87: YoConf.AbortRollout:output_type -> Resp
+	5,  // 88: YoConf.SetTargeting:output_type -> Resp
+	36, // 89: YoConf.GetTargeting:output_type -> Targeting
+	39, // 90: YoConf.EvaluateTargeting:output_type -> TargetingResult
+	40, // 91: YoConf.ScheduleRollOn:output_type -> Schedule
+	42, // 92: YoConf.ListSchedules:output_type -> SchedulesResponse
+	5,  // 93: YoConf.CancelSchedule:output_type -> Resp
+	46, // 94: YoConf.ListAudit:output_type -> AuditResponse
+	47, // 95: YoConf.StartOverride:output_type -> Override
+	47, // 96: YoConf.ExtendOverride:output_type -> Override
+	5,  // 97: YoConf.EndOverride:output_type -> Resp
+	47, // 98: YoConf.GetOverride:output_type -> Override
+	52, // 99: YoConf.ApproveProposal:output_type -> Proposal
+	52, // 100: YoConf.RejectProposal:output_type -> Proposal
+	55, // 101: YoConf.ListProposals:output_type -> ProposalsResponse
+	56, // 102: YoConf.LockProject:output_type -> Lock
+	5,  // 103: YoConf.UnlockProject:output_type -> Resp
+	56, // 104: YoConf.GetLock:output_type -> Lock
+	59, // 105: YoConf.SetFreezeWindow:output_type -> FreezeWindow
+	5,  // 106: YoConf.DeleteFreezeWindow:output_type -> Resp
+	61, // 107: YoConf.ListFreezeWindows:output_type -> FreezeWindowsResponse
+	64, // 108: YoConf.EvaluateFlags:output_type -> FlagsResponse
+	65, // [65:109] is the sub-list for method output_type
+	21, // [21:65] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_proto_yoconf_proto_init() }
@@ -304,7 +4796,7 @@ func file_proto_yoconf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_yoconf_proto_rawDesc), len(file_proto_yoconf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   68,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
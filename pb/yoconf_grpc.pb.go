@@ -19,9 +19,50 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	YoConf_CreateChunk_FullMethodName = "/YoConf/CreateChunk"
-	YoConf_RollOn_FullMethodName      = "/YoConf/RollOn"
-	YoConf_DeleteChunk_FullMethodName = "/YoConf/DeleteChunk"
+	YoConf_CreateChunk_FullMethodName        = "/YoConf/CreateChunk"
+	YoConf_RollOn_FullMethodName             = "/YoConf/RollOn"
+	YoConf_DeleteChunk_FullMethodName        = "/YoConf/DeleteChunk"
+	YoConf_Diff_FullMethodName               = "/YoConf/Diff"
+	YoConf_SetProject_FullMethodName         = "/YoConf/SetProject"
+	YoConf_GetProject_FullMethodName         = "/YoConf/GetProject"
+	YoConf_SetSchema_FullMethodName          = "/YoConf/SetSchema"
+	YoConf_GetSchema_FullMethodName          = "/YoConf/GetSchema"
+	YoConf_ValidateChunk_FullMethodName      = "/YoConf/ValidateChunk"
+	YoConf_SetRule_FullMethodName            = "/YoConf/SetRule"
+	YoConf_DeleteRule_FullMethodName         = "/YoConf/DeleteRule"
+	YoConf_ListRules_FullMethodName          = "/YoConf/ListRules"
+	YoConf_ListEnvironments_FullMethodName   = "/YoConf/ListEnvironments"
+	YoConf_Promote_FullMethodName            = "/YoConf/Promote"
+	YoConf_Patch_FullMethodName              = "/YoConf/Patch"
+	YoConf_ListDependencies_FullMethodName   = "/YoConf/ListDependencies"
+	YoConf_GetConfig_FullMethodName          = "/YoConf/GetConfig"
+	YoConf_ListSigningKeys_FullMethodName    = "/YoConf/ListSigningKeys"
+	YoConf_StartRollout_FullMethodName       = "/YoConf/StartRollout"
+	YoConf_GetRollout_FullMethodName         = "/YoConf/GetRollout"
+	YoConf_SetRolloutPercent_FullMethodName  = "/YoConf/SetRolloutPercent"
+	YoConf_PromoteRollout_FullMethodName     = "/YoConf/PromoteRollout"
+	YoConf_AbortRollout_FullMethodName       = "/YoConf/AbortRollout"
+	YoConf_SetTargeting_FullMethodName       = "/YoConf/SetTargeting"
+	YoConf_GetTargeting_FullMethodName       = "/YoConf/GetTargeting"
+	YoConf_EvaluateTargeting_FullMethodName  = "/YoConf/EvaluateTargeting"
+	YoConf_ScheduleRollOn_FullMethodName     = "/YoConf/ScheduleRollOn"
+	YoConf_ListSchedules_FullMethodName      = "/YoConf/ListSchedules"
+	YoConf_CancelSchedule_FullMethodName     = "/YoConf/CancelSchedule"
+	YoConf_ListAudit_FullMethodName          = "/YoConf/ListAudit"
+	YoConf_StartOverride_FullMethodName      = "/YoConf/StartOverride"
+	YoConf_ExtendOverride_FullMethodName     = "/YoConf/ExtendOverride"
+	YoConf_EndOverride_FullMethodName        = "/YoConf/EndOverride"
+	YoConf_GetOverride_FullMethodName        = "/YoConf/GetOverride"
+	YoConf_ApproveProposal_FullMethodName    = "/YoConf/ApproveProposal"
+	YoConf_RejectProposal_FullMethodName     = "/YoConf/RejectProposal"
+	YoConf_ListProposals_FullMethodName      = "/YoConf/ListProposals"
+	YoConf_LockProject_FullMethodName        = "/YoConf/LockProject"
+	YoConf_UnlockProject_FullMethodName      = "/YoConf/UnlockProject"
+	YoConf_GetLock_FullMethodName            = "/YoConf/GetLock"
+	YoConf_SetFreezeWindow_FullMethodName    = "/YoConf/SetFreezeWindow"
+	YoConf_DeleteFreezeWindow_FullMethodName = "/YoConf/DeleteFreezeWindow"
+	YoConf_ListFreezeWindows_FullMethodName  = "/YoConf/ListFreezeWindows"
+	YoConf_EvaluateFlags_FullMethodName      = "/YoConf/EvaluateFlags"
 )
 
 // YoConfClient is the client API for YoConf service.
@@ -31,6 +72,47 @@ type YoConfClient interface {
 	CreateChunk(ctx context.Context, in *Chunk, opts ...grpc.CallOption) (*Resp, error)
 	RollOn(ctx context.Context, in *RollOnRequest, opts ...grpc.CallOption) (*Resp, error)
 	DeleteChunk(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*Resp, error)
+	Diff(ctx context.Context, in *DiffRequest, opts ...grpc.CallOption) (*DiffResponse, error)
+	SetProject(ctx context.Context, in *Project, opts ...grpc.CallOption) (*Resp, error)
+	GetProject(ctx context.Context, in *GetProjectRequest, opts ...grpc.CallOption) (*Project, error)
+	SetSchema(ctx context.Context, in *Schema, opts ...grpc.CallOption) (*Schema, error)
+	GetSchema(ctx context.Context, in *GetSchemaRequest, opts ...grpc.CallOption) (*Schema, error)
+	ValidateChunk(ctx context.Context, in *Chunk, opts ...grpc.CallOption) (*ValidateResponse, error)
+	SetRule(ctx context.Context, in *Rule, opts ...grpc.CallOption) (*Resp, error)
+	DeleteRule(ctx context.Context, in *DeleteRuleRequest, opts ...grpc.CallOption) (*Resp, error)
+	ListRules(ctx context.Context, in *ListRulesRequest, opts ...grpc.CallOption) (*RulesResponse, error)
+	ListEnvironments(ctx context.Context, in *ListEnvironmentsRequest, opts ...grpc.CallOption) (*EnvironmentsResponse, error)
+	Promote(ctx context.Context, in *PromoteRequest, opts ...grpc.CallOption) (*Chunk, error)
+	Patch(ctx context.Context, in *PatchRequest, opts ...grpc.CallOption) (*Chunk, error)
+	ListDependencies(ctx context.Context, in *ListDependenciesRequest, opts ...grpc.CallOption) (*DependenciesResponse, error)
+	GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*Chunk, error)
+	ListSigningKeys(ctx context.Context, in *ListSigningKeysRequest, opts ...grpc.CallOption) (*SigningKeysResponse, error)
+	StartRollout(ctx context.Context, in *Rollout, opts ...grpc.CallOption) (*Rollout, error)
+	GetRollout(ctx context.Context, in *RolloutRequest, opts ...grpc.CallOption) (*Rollout, error)
+	SetRolloutPercent(ctx context.Context, in *RolloutPercentRequest, opts ...grpc.CallOption) (*Rollout, error)
+	PromoteRollout(ctx context.Context, in *RolloutRequest, opts ...grpc.CallOption) (*Resp, error)
+	AbortRollout(ctx context.Context, in *RolloutRequest, opts ...grpc.CallOption) (*Resp, error)
+	SetTargeting(ctx context.Context, in *Targeting, opts ...grpc.CallOption) (*Resp, error)
+	GetTargeting(ctx context.Context, in *GetTargetingRequest, opts ...grpc.CallOption) (*Targeting, error)
+	EvaluateTargeting(ctx context.Context, in *EvaluateTargetingRequest, opts ...grpc.CallOption) (*TargetingResult, error)
+	ScheduleRollOn(ctx context.Context, in *Schedule, opts ...grpc.CallOption) (*Schedule, error)
+	ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*SchedulesResponse, error)
+	CancelSchedule(ctx context.Context, in *CancelScheduleRequest, opts ...grpc.CallOption) (*Resp, error)
+	ListAudit(ctx context.Context, in *ListAuditRequest, opts ...grpc.CallOption) (*AuditResponse, error)
+	StartOverride(ctx context.Context, in *StartOverrideRequest, opts ...grpc.CallOption) (*Override, error)
+	ExtendOverride(ctx context.Context, in *ExtendOverrideRequest, opts ...grpc.CallOption) (*Override, error)
+	EndOverride(ctx context.Context, in *OverrideRequest, opts ...grpc.CallOption) (*Resp, error)
+	GetOverride(ctx context.Context, in *OverrideRequest, opts ...grpc.CallOption) (*Override, error)
+	ApproveProposal(ctx context.Context, in *ReviewRequest, opts ...grpc.CallOption) (*Proposal, error)
+	RejectProposal(ctx context.Context, in *ReviewRequest, opts ...grpc.CallOption) (*Proposal, error)
+	ListProposals(ctx context.Context, in *ListProposalsRequest, opts ...grpc.CallOption) (*ProposalsResponse, error)
+	LockProject(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*Lock, error)
+	UnlockProject(ctx context.Context, in *ProjectRequest, opts ...grpc.CallOption) (*Resp, error)
+	GetLock(ctx context.Context, in *ProjectRequest, opts ...grpc.CallOption) (*Lock, error)
+	SetFreezeWindow(ctx context.Context, in *FreezeWindow, opts ...grpc.CallOption) (*FreezeWindow, error)
+	DeleteFreezeWindow(ctx context.Context, in *DeleteFreezeWindowRequest, opts ...grpc.CallOption) (*Resp, error)
+	ListFreezeWindows(ctx context.Context, in *ProjectRequest, opts ...grpc.CallOption) (*FreezeWindowsResponse, error)
+	EvaluateFlags(ctx context.Context, in *EvaluateFlagsRequest, opts ...grpc.CallOption) (*FlagsResponse, error)
 }
 
 type yoConfClient struct {
@@ -71,6 +153,416 @@ func (c *yoConfClient) DeleteChunk(ctx context.Context, in *DeleteRequest, opts
 	return out, nil
 }
 
+func (c *yoConfClient) Diff(ctx context.Context, in *DiffRequest, opts ...grpc.CallOption) (*DiffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffResponse)
+	err := c.cc.Invoke(ctx, YoConf_Diff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yoConfClient) SetProject(ctx context.Context, in *Project, opts ...grpc.CallOption) (*Resp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Resp)
+	err := c.cc.Invoke(ctx, YoConf_SetProject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yoConfClient) GetProject(ctx context.Context, in *GetProjectRequest, opts ...grpc.CallOption) (*Project, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Project)
+	err := c.cc.Invoke(ctx, YoConf_GetProject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yoConfClient) SetSchema(ctx context.Context, in *Schema, opts ...grpc.CallOption) (*Schema, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Schema)
+	err := c.cc.Invoke(ctx, YoConf_SetSchema_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yoConfClient) GetSchema(ctx context.Context, in *GetSchemaRequest, opts ...grpc.CallOption) (*Schema, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Schema)
+	err := c.cc.Invoke(ctx, YoConf_GetSchema_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yoConfClient) ValidateChunk(ctx context.Context, in *Chunk, opts ...grpc.CallOption) (*ValidateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateResponse)
+	err := c.cc.Invoke(ctx, YoConf_ValidateChunk_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yoConfClient) SetRule(ctx context.Context, in *Rule, opts ...grpc.CallOption) (*Resp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Resp)
+	err := c.cc.Invoke(ctx, YoConf_SetRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yoConfClient) DeleteRule(ctx context.Context, in *DeleteRuleRequest, opts ...grpc.CallOption) (*Resp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Resp)
+	err := c.cc.Invoke(ctx, YoConf_DeleteRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yoConfClient) ListRules(ctx context.Context, in *ListRulesRequest, opts ...grpc.CallOption) (*RulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RulesResponse)
+	err := c.cc.Invoke(ctx, YoConf_ListRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yoConfClient) ListEnvironments(ctx context.Context, in *ListEnvironmentsRequest, opts ...grpc.CallOption) (*EnvironmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnvironmentsResponse)
+	err := c.cc.Invoke(ctx, YoConf_ListEnvironments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yoConfClient) Promote(ctx context.Context, in *PromoteRequest, opts ...grpc.CallOption) (*Chunk, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Chunk)
+	err := c.cc.Invoke(ctx, YoConf_Promote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yoConfClient) Patch(ctx context.Context, in *PatchRequest, opts ...grpc.CallOption) (*Chunk, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Chunk)
+	err := c.cc.Invoke(ctx, YoConf_Patch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yoConfClient) ListDependencies(ctx context.Context, in *ListDependenciesRequest, opts ...grpc.CallOption) (*DependenciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DependenciesResponse)
+	err := c.cc.Invoke(ctx, YoConf_ListDependencies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yoConfClient) GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*Chunk, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Chunk)
+	err := c.cc.Invoke(ctx, YoConf_GetConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yoConfClient) ListSigningKeys(ctx context.Context, in *ListSigningKeysRequest, opts ...grpc.CallOption) (*SigningKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SigningKeysResponse)
+	err := c.cc.Invoke(ctx, YoConf_ListSigningKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yoConfClient) StartRollout(ctx context.Context, in *Rollout, opts ...grpc.CallOption) (*Rollout, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Rollout)
+	err := c.cc.Invoke(ctx, YoConf_StartRollout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yoConfClient) GetRollout(ctx context.Context, in *RolloutRequest, opts ...grpc.CallOption) (*Rollout, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Rollout)
+	err := c.cc.Invoke(ctx, YoConf_GetRollout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yoConfClient) SetRolloutPercent(ctx context.Context, in *RolloutPercentRequest, opts ...grpc.CallOption) (*Rollout, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Rollout)
+	err := c.cc.Invoke(ctx, YoConf_SetRolloutPercent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yoConfClient) PromoteRollout(ctx context.Context, in *RolloutRequest, opts ...grpc.CallOption) (*Resp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Resp)
+	err := c.cc.Invoke(ctx, YoConf_PromoteRollout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yoConfClient) AbortRollout(ctx context.Context, in *RolloutRequest, opts ...grpc.CallOption) (*Resp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Resp)
+	err := c.cc.Invoke(ctx, YoConf_AbortRollout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yoConfClient) SetTargeting(ctx context.Context, in *Targeting, opts ...grpc.CallOption) (*Resp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Resp)
+	err := c.cc.Invoke(ctx, YoConf_SetTargeting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yoConfClient) GetTargeting(ctx context.Context, in *GetTargetingRequest, opts ...grpc.CallOption) (*Targeting, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Targeting)
+	err := c.cc.Invoke(ctx, YoConf_GetTargeting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yoConfClient) EvaluateTargeting(ctx context.Context, in *EvaluateTargetingRequest, opts ...grpc.CallOption) (*TargetingResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TargetingResult)
+	err := c.cc.Invoke(ctx, YoConf_EvaluateTargeting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yoConfClient) ScheduleRollOn(ctx context.Context, in *Schedule, opts ...grpc.CallOption) (*Schedule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Schedule)
+	err := c.cc.Invoke(ctx, YoConf_ScheduleRollOn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yoConfClient) ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*SchedulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SchedulesResponse)
+	err := c.cc.Invoke(ctx, YoConf_ListSchedules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yoConfClient) CancelSchedule(ctx context.Context, in *CancelScheduleRequest, opts ...grpc.CallOption) (*Resp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Resp)
+	err := c.cc.Invoke(ctx, YoConf_CancelSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yoConfClient) ListAudit(ctx context.Context, in *ListAuditRequest, opts ...grpc.CallOption) (*AuditResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditResponse)
+	err := c.cc.Invoke(ctx, YoConf_ListAudit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yoConfClient) StartOverride(ctx context.Context, in *StartOverrideRequest, opts ...grpc.CallOption) (*Override, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Override)
+	err := c.cc.Invoke(ctx, YoConf_StartOverride_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yoConfClient) ExtendOverride(ctx context.Context, in *ExtendOverrideRequest, opts ...grpc.CallOption) (*Override, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Override)
+	err := c.cc.Invoke(ctx, YoConf_ExtendOverride_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yoConfClient) EndOverride(ctx context.Context, in *OverrideRequest, opts ...grpc.CallOption) (*Resp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Resp)
+	err := c.cc.Invoke(ctx, YoConf_EndOverride_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yoConfClient) GetOverride(ctx context.Context, in *OverrideRequest, opts ...grpc.CallOption) (*Override, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Override)
+	err := c.cc.Invoke(ctx, YoConf_GetOverride_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yoConfClient) ApproveProposal(ctx context.Context, in *ReviewRequest, opts ...grpc.CallOption) (*Proposal, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Proposal)
+	err := c.cc.Invoke(ctx, YoConf_ApproveProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yoConfClient) RejectProposal(ctx context.Context, in *ReviewRequest, opts ...grpc.CallOption) (*Proposal, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Proposal)
+	err := c.cc.Invoke(ctx, YoConf_RejectProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yoConfClient) ListProposals(ctx context.Context, in *ListProposalsRequest, opts ...grpc.CallOption) (*ProposalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProposalsResponse)
+	err := c.cc.Invoke(ctx, YoConf_ListProposals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yoConfClient) LockProject(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*Lock, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Lock)
+	err := c.cc.Invoke(ctx, YoConf_LockProject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yoConfClient) UnlockProject(ctx context.Context, in *ProjectRequest, opts ...grpc.CallOption) (*Resp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Resp)
+	err := c.cc.Invoke(ctx, YoConf_UnlockProject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yoConfClient) GetLock(ctx context.Context, in *ProjectRequest, opts ...grpc.CallOption) (*Lock, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Lock)
+	err := c.cc.Invoke(ctx, YoConf_GetLock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yoConfClient) SetFreezeWindow(ctx context.Context, in *FreezeWindow, opts ...grpc.CallOption) (*FreezeWindow, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FreezeWindow)
+	err := c.cc.Invoke(ctx, YoConf_SetFreezeWindow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yoConfClient) DeleteFreezeWindow(ctx context.Context, in *DeleteFreezeWindowRequest, opts ...grpc.CallOption) (*Resp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Resp)
+	err := c.cc.Invoke(ctx, YoConf_DeleteFreezeWindow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yoConfClient) ListFreezeWindows(ctx context.Context, in *ProjectRequest, opts ...grpc.CallOption) (*FreezeWindowsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FreezeWindowsResponse)
+	err := c.cc.Invoke(ctx, YoConf_ListFreezeWindows_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yoConfClient) EvaluateFlags(ctx context.Context, in *EvaluateFlagsRequest, opts ...grpc.CallOption) (*FlagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FlagsResponse)
+	err := c.cc.Invoke(ctx, YoConf_EvaluateFlags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // YoConfServer is the server API for YoConf service.
 // All implementations must embed UnimplementedYoConfServer
 // for forward compatibility.
@@ -78,96 +570,998 @@ type YoConfServer interface {
 	CreateChunk(context.Context, *Chunk) (*Resp, error)
 	RollOn(context.Context, *RollOnRequest) (*Resp, error)
 	DeleteChunk(context.Context, *DeleteRequest) (*Resp, error)
+	Diff(context.Context, *DiffRequest) (*DiffResponse, error)
+	SetProject(context.Context, *Project) (*Resp, error)
+	GetProject(context.Context, *GetProjectRequest) (*Project, error)
+	SetSchema(context.Context, *Schema) (*Schema, error)
+	GetSchema(context.Context, *GetSchemaRequest) (*Schema, error)
+	ValidateChunk(context.Context, *Chunk) (*ValidateResponse, error)
+	SetRule(context.Context, *Rule) (*Resp, error)
+	DeleteRule(context.Context, *DeleteRuleRequest) (*Resp, error)
+	ListRules(context.Context, *ListRulesRequest) (*RulesResponse, error)
+	ListEnvironments(context.Context, *ListEnvironmentsRequest) (*EnvironmentsResponse, error)
+	Promote(context.Context, *PromoteRequest) (*Chunk, error)
+	Patch(context.Context, *PatchRequest) (*Chunk, error)
+	ListDependencies(context.Context, *ListDependenciesRequest) (*DependenciesResponse, error)
+	GetConfig(context.Context, *GetConfigRequest) (*Chunk, error)
+	ListSigningKeys(context.Context, *ListSigningKeysRequest) (*SigningKeysResponse, error)
+	StartRollout(context.Context, *Rollout) (*Rollout, error)
+	GetRollout(context.Context, *RolloutRequest) (*Rollout, error)
+	SetRolloutPercent(context.Context, *RolloutPercentRequest) (*Rollout, error)
+	PromoteRollout(context.Context, *RolloutRequest) (*Resp, error)
+	AbortRollout(context.Context, *RolloutRequest) (*Resp, error)
+	SetTargeting(context.Context, *Targeting) (*Resp, error)
+	GetTargeting(context.Context, *GetTargetingRequest) (*Targeting, error)
+	EvaluateTargeting(context.Context, *EvaluateTargetingRequest) (*TargetingResult, error)
+	ScheduleRollOn(context.Context, *Schedule) (*Schedule, error)
+	ListSchedules(context.Context, *ListSchedulesRequest) (*SchedulesResponse, error)
+	CancelSchedule(context.Context, *CancelScheduleRequest) (*Resp, error)
+	ListAudit(context.Context, *ListAuditRequest) (*AuditResponse, error)
+	StartOverride(context.Context, *StartOverrideRequest) (*Override, error)
+	ExtendOverride(context.Context, *ExtendOverrideRequest) (*Override, error)
+	EndOverride(context.Context, *OverrideRequest) (*Resp, error)
+	GetOverride(context.Context, *OverrideRequest) (*Override, error)
+	ApproveProposal(context.Context, *ReviewRequest) (*Proposal, error)
+	RejectProposal(context.Context, *ReviewRequest) (*Proposal, error)
+	ListProposals(context.Context, *ListProposalsRequest) (*ProposalsResponse, error)
+	LockProject(context.Context, *LockRequest) (*Lock, error)
+	UnlockProject(context.Context, *ProjectRequest) (*Resp, error)
+	GetLock(context.Context, *ProjectRequest) (*Lock, error)
+	SetFreezeWindow(context.Context, *FreezeWindow) (*FreezeWindow, error)
+	DeleteFreezeWindow(context.Context, *DeleteFreezeWindowRequest) (*Resp, error)
+	ListFreezeWindows(context.Context, *ProjectRequest) (*FreezeWindowsResponse, error)
+	EvaluateFlags(context.Context, *EvaluateFlagsRequest) (*FlagsResponse, error)
+	mustEmbedUnimplementedYoConfServer()
+}
+
+// UnimplementedYoConfServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedYoConfServer struct{}
+
+func (UnimplementedYoConfServer) CreateChunk(context.Context, *Chunk) (*Resp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateChunk not implemented")
+}
+func (UnimplementedYoConfServer) RollOn(context.Context, *RollOnRequest) (*Resp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollOn not implemented")
+}
+func (UnimplementedYoConfServer) DeleteChunk(context.Context, *DeleteRequest) (*Resp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteChunk not implemented")
+}
+func (UnimplementedYoConfServer) Diff(context.Context, *DiffRequest) (*DiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Diff not implemented")
+}
+func (UnimplementedYoConfServer) SetProject(context.Context, *Project) (*Resp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProject not implemented")
+}
+func (UnimplementedYoConfServer) GetProject(context.Context, *GetProjectRequest) (*Project, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProject not implemented")
+}
+func (UnimplementedYoConfServer) SetSchema(context.Context, *Schema) (*Schema, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSchema not implemented")
+}
+func (UnimplementedYoConfServer) GetSchema(context.Context, *GetSchemaRequest) (*Schema, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchema not implemented")
+}
+func (UnimplementedYoConfServer) ValidateChunk(context.Context, *Chunk) (*ValidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateChunk not implemented")
+}
+func (UnimplementedYoConfServer) SetRule(context.Context, *Rule) (*Resp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRule not implemented")
+}
+func (UnimplementedYoConfServer) DeleteRule(context.Context, *DeleteRuleRequest) (*Resp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRule not implemented")
+}
+func (UnimplementedYoConfServer) ListRules(context.Context, *ListRulesRequest) (*RulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRules not implemented")
+}
+func (UnimplementedYoConfServer) ListEnvironments(context.Context, *ListEnvironmentsRequest) (*EnvironmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEnvironments not implemented")
+}
+func (UnimplementedYoConfServer) Promote(context.Context, *PromoteRequest) (*Chunk, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Promote not implemented")
+}
+func (UnimplementedYoConfServer) Patch(context.Context, *PatchRequest) (*Chunk, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Patch not implemented")
+}
+func (UnimplementedYoConfServer) ListDependencies(context.Context, *ListDependenciesRequest) (*DependenciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDependencies not implemented")
+}
+func (UnimplementedYoConfServer) GetConfig(context.Context, *GetConfigRequest) (*Chunk, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfig not implemented")
+}
+func (UnimplementedYoConfServer) ListSigningKeys(context.Context, *ListSigningKeysRequest) (*SigningKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSigningKeys not implemented")
+}
+func (UnimplementedYoConfServer) StartRollout(context.Context, *Rollout) (*Rollout, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartRollout not implemented")
+}
+func (UnimplementedYoConfServer) GetRollout(context.Context, *RolloutRequest) (*Rollout, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRollout not implemented")
+}
+func (UnimplementedYoConfServer) SetRolloutPercent(context.Context, *RolloutPercentRequest) (*Rollout, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRolloutPercent not implemented")
+}
+func (UnimplementedYoConfServer) PromoteRollout(context.Context, *RolloutRequest) (*Resp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteRollout not implemented")
+}
+func (UnimplementedYoConfServer) AbortRollout(context.Context, *RolloutRequest) (*Resp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortRollout not implemented")
+}
+func (UnimplementedYoConfServer) SetTargeting(context.Context, *Targeting) (*Resp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTargeting not implemented")
+}
+func (UnimplementedYoConfServer) GetTargeting(context.Context, *GetTargetingRequest) (*Targeting, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTargeting not implemented")
+}
+func (UnimplementedYoConfServer) EvaluateTargeting(context.Context, *EvaluateTargetingRequest) (*TargetingResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvaluateTargeting not implemented")
+}
+func (UnimplementedYoConfServer) ScheduleRollOn(context.Context, *Schedule) (*Schedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleRollOn not implemented")
+}
+func (UnimplementedYoConfServer) ListSchedules(context.Context, *ListSchedulesRequest) (*SchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSchedules not implemented")
+}
+func (UnimplementedYoConfServer) CancelSchedule(context.Context, *CancelScheduleRequest) (*Resp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSchedule not implemented")
+}
+func (UnimplementedYoConfServer) ListAudit(context.Context, *ListAuditRequest) (*AuditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAudit not implemented")
+}
+func (UnimplementedYoConfServer) StartOverride(context.Context, *StartOverrideRequest) (*Override, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartOverride not implemented")
+}
+func (UnimplementedYoConfServer) ExtendOverride(context.Context, *ExtendOverrideRequest) (*Override, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendOverride not implemented")
+}
+func (UnimplementedYoConfServer) EndOverride(context.Context, *OverrideRequest) (*Resp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EndOverride not implemented")
+}
+func (UnimplementedYoConfServer) GetOverride(context.Context, *OverrideRequest) (*Override, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOverride not implemented")
+}
+func (UnimplementedYoConfServer) ApproveProposal(context.Context, *ReviewRequest) (*Proposal, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveProposal not implemented")
+}
+func (UnimplementedYoConfServer) RejectProposal(context.Context, *ReviewRequest) (*Proposal, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectProposal not implemented")
+}
+func (UnimplementedYoConfServer) ListProposals(context.Context, *ListProposalsRequest) (*ProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProposals not implemented")
+}
+func (UnimplementedYoConfServer) LockProject(context.Context, *LockRequest) (*Lock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockProject not implemented")
+}
+func (UnimplementedYoConfServer) UnlockProject(context.Context, *ProjectRequest) (*Resp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockProject not implemented")
+}
+func (UnimplementedYoConfServer) GetLock(context.Context, *ProjectRequest) (*Lock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLock not implemented")
+}
+func (UnimplementedYoConfServer) SetFreezeWindow(context.Context, *FreezeWindow) (*FreezeWindow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFreezeWindow not implemented")
+}
+func (UnimplementedYoConfServer) DeleteFreezeWindow(context.Context, *DeleteFreezeWindowRequest) (*Resp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFreezeWindow not implemented")
+}
+func (UnimplementedYoConfServer) ListFreezeWindows(context.Context, *ProjectRequest) (*FreezeWindowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFreezeWindows not implemented")
+}
+func (UnimplementedYoConfServer) EvaluateFlags(context.Context, *EvaluateFlagsRequest) (*FlagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvaluateFlags not implemented")
+}
+func (UnimplementedYoConfServer) mustEmbedUnimplementedYoConfServer() {}
+func (UnimplementedYoConfServer) testEmbeddedByValue()                {}
+
+// UnsafeYoConfServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to YoConfServer will
+// result in compilation errors.
+type UnsafeYoConfServer interface {
 	mustEmbedUnimplementedYoConfServer()
 }
 
-// UnimplementedYoConfServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedYoConfServer struct{}
+func RegisterYoConfServer(s grpc.ServiceRegistrar, srv YoConfServer) {
+	// If the following call pancis, it indicates UnimplementedYoConfServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&YoConf_ServiceDesc, srv)
+}
+
+func _YoConf_CreateChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Chunk)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YoConfServer).CreateChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: YoConf_CreateChunk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoConfServer).CreateChunk(ctx, req.(*Chunk))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _YoConf_RollOn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollOnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YoConfServer).RollOn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: YoConf_RollOn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoConfServer).RollOn(ctx, req.(*RollOnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _YoConf_DeleteChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YoConfServer).DeleteChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: YoConf_DeleteChunk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoConfServer).DeleteChunk(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _YoConf_Diff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YoConfServer).Diff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: YoConf_Diff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoConfServer).Diff(ctx, req.(*DiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _YoConf_SetProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Project)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YoConfServer).SetProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: YoConf_SetProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoConfServer).SetProject(ctx, req.(*Project))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _YoConf_GetProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YoConfServer).GetProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: YoConf_GetProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoConfServer).GetProject(ctx, req.(*GetProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _YoConf_SetSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Schema)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YoConfServer).SetSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: YoConf_SetSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoConfServer).SetSchema(ctx, req.(*Schema))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _YoConf_GetSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YoConfServer).GetSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: YoConf_GetSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoConfServer).GetSchema(ctx, req.(*GetSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _YoConf_ValidateChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Chunk)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YoConfServer).ValidateChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: YoConf_ValidateChunk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoConfServer).ValidateChunk(ctx, req.(*Chunk))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _YoConf_SetRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Rule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YoConfServer).SetRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: YoConf_SetRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoConfServer).SetRule(ctx, req.(*Rule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _YoConf_DeleteRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YoConfServer).DeleteRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: YoConf_DeleteRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoConfServer).DeleteRule(ctx, req.(*DeleteRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _YoConf_ListRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YoConfServer).ListRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: YoConf_ListRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoConfServer).ListRules(ctx, req.(*ListRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _YoConf_ListEnvironments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEnvironmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YoConfServer).ListEnvironments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: YoConf_ListEnvironments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoConfServer).ListEnvironments(ctx, req.(*ListEnvironmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _YoConf_Promote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YoConfServer).Promote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: YoConf_Promote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoConfServer).Promote(ctx, req.(*PromoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _YoConf_Patch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YoConfServer).Patch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: YoConf_Patch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoConfServer).Patch(ctx, req.(*PatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _YoConf_ListDependencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDependenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YoConfServer).ListDependencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: YoConf_ListDependencies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoConfServer).ListDependencies(ctx, req.(*ListDependenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _YoConf_GetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YoConfServer).GetConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: YoConf_GetConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoConfServer).GetConfig(ctx, req.(*GetConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _YoConf_ListSigningKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSigningKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YoConfServer).ListSigningKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: YoConf_ListSigningKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoConfServer).ListSigningKeys(ctx, req.(*ListSigningKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _YoConf_StartRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Rollout)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YoConfServer).StartRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: YoConf_StartRollout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoConfServer).StartRollout(ctx, req.(*Rollout))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _YoConf_GetRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RolloutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YoConfServer).GetRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: YoConf_GetRollout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoConfServer).GetRollout(ctx, req.(*RolloutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _YoConf_SetRolloutPercent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RolloutPercentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YoConfServer).SetRolloutPercent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: YoConf_SetRolloutPercent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoConfServer).SetRolloutPercent(ctx, req.(*RolloutPercentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _YoConf_PromoteRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RolloutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YoConfServer).PromoteRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: YoConf_PromoteRollout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoConfServer).PromoteRollout(ctx, req.(*RolloutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _YoConf_AbortRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RolloutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YoConfServer).AbortRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: YoConf_AbortRollout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoConfServer).AbortRollout(ctx, req.(*RolloutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _YoConf_SetTargeting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Targeting)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YoConfServer).SetTargeting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: YoConf_SetTargeting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoConfServer).SetTargeting(ctx, req.(*Targeting))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _YoConf_GetTargeting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTargetingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YoConfServer).GetTargeting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: YoConf_GetTargeting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoConfServer).GetTargeting(ctx, req.(*GetTargetingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _YoConf_EvaluateTargeting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateTargetingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YoConfServer).EvaluateTargeting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: YoConf_EvaluateTargeting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoConfServer).EvaluateTargeting(ctx, req.(*EvaluateTargetingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _YoConf_ScheduleRollOn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Schedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YoConfServer).ScheduleRollOn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: YoConf_ScheduleRollOn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoConfServer).ScheduleRollOn(ctx, req.(*Schedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _YoConf_ListSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YoConfServer).ListSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: YoConf_ListSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoConfServer).ListSchedules(ctx, req.(*ListSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _YoConf_CancelSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YoConfServer).CancelSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: YoConf_CancelSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoConfServer).CancelSchedule(ctx, req.(*CancelScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _YoConf_ListAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YoConfServer).ListAudit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: YoConf_ListAudit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoConfServer).ListAudit(ctx, req.(*ListAuditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _YoConf_StartOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YoConfServer).StartOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: YoConf_StartOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoConfServer).StartOverride(ctx, req.(*StartOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _YoConf_ExtendOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YoConfServer).ExtendOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: YoConf_ExtendOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoConfServer).ExtendOverride(ctx, req.(*ExtendOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (UnimplementedYoConfServer) CreateChunk(context.Context, *Chunk) (*Resp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateChunk not implemented")
+func _YoConf_EndOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YoConfServer).EndOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: YoConf_EndOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoConfServer).EndOverride(ctx, req.(*OverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedYoConfServer) RollOn(context.Context, *RollOnRequest) (*Resp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RollOn not implemented")
+
+func _YoConf_GetOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YoConfServer).GetOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: YoConf_GetOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoConfServer).GetOverride(ctx, req.(*OverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedYoConfServer) DeleteChunk(context.Context, *DeleteRequest) (*Resp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteChunk not implemented")
+
+func _YoConf_ApproveProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YoConfServer).ApproveProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: YoConf_ApproveProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoConfServer).ApproveProposal(ctx, req.(*ReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedYoConfServer) mustEmbedUnimplementedYoConfServer() {}
-func (UnimplementedYoConfServer) testEmbeddedByValue()                {}
 
-// UnsafeYoConfServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to YoConfServer will
-// result in compilation errors.
-type UnsafeYoConfServer interface {
-	mustEmbedUnimplementedYoConfServer()
+func _YoConf_RejectProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YoConfServer).RejectProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: YoConf_RejectProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoConfServer).RejectProposal(ctx, req.(*ReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterYoConfServer(s grpc.ServiceRegistrar, srv YoConfServer) {
-	// If the following call pancis, it indicates UnimplementedYoConfServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _YoConf_ListProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&YoConf_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(YoConfServer).ListProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: YoConf_ListProposals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoConfServer).ListProposals(ctx, req.(*ListProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _YoConf_CreateChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Chunk)
+func _YoConf_LockProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(YoConfServer).CreateChunk(ctx, in)
+		return srv.(YoConfServer).LockProject(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: YoConf_CreateChunk_FullMethodName,
+		FullMethod: YoConf_LockProject_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(YoConfServer).CreateChunk(ctx, req.(*Chunk))
+		return srv.(YoConfServer).LockProject(ctx, req.(*LockRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _YoConf_RollOn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RollOnRequest)
+func _YoConf_UnlockProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(YoConfServer).RollOn(ctx, in)
+		return srv.(YoConfServer).UnlockProject(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: YoConf_RollOn_FullMethodName,
+		FullMethod: YoConf_UnlockProject_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(YoConfServer).RollOn(ctx, req.(*RollOnRequest))
+		return srv.(YoConfServer).UnlockProject(ctx, req.(*ProjectRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _YoConf_DeleteChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteRequest)
+func _YoConf_GetLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(YoConfServer).DeleteChunk(ctx, in)
+		return srv.(YoConfServer).GetLock(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: YoConf_DeleteChunk_FullMethodName,
+		FullMethod: YoConf_GetLock_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(YoConfServer).DeleteChunk(ctx, req.(*DeleteRequest))
+		return srv.(YoConfServer).GetLock(ctx, req.(*ProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _YoConf_SetFreezeWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FreezeWindow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YoConfServer).SetFreezeWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: YoConf_SetFreezeWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoConfServer).SetFreezeWindow(ctx, req.(*FreezeWindow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _YoConf_DeleteFreezeWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFreezeWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YoConfServer).DeleteFreezeWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: YoConf_DeleteFreezeWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoConfServer).DeleteFreezeWindow(ctx, req.(*DeleteFreezeWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _YoConf_ListFreezeWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YoConfServer).ListFreezeWindows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: YoConf_ListFreezeWindows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoConfServer).ListFreezeWindows(ctx, req.(*ProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _YoConf_EvaluateFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateFlagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YoConfServer).EvaluateFlags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: YoConf_EvaluateFlags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YoConfServer).EvaluateFlags(ctx, req.(*EvaluateFlagsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -191,6 +1585,170 @@ var YoConf_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteChunk",
 			Handler:    _YoConf_DeleteChunk_Handler,
 		},
+		{
+			MethodName: "Diff",
+			Handler:    _YoConf_Diff_Handler,
+		},
+		{
+			MethodName: "SetProject",
+			Handler:    _YoConf_SetProject_Handler,
+		},
+		{
+			MethodName: "GetProject",
+			Handler:    _YoConf_GetProject_Handler,
+		},
+		{
+			MethodName: "SetSchema",
+			Handler:    _YoConf_SetSchema_Handler,
+		},
+		{
+			MethodName: "GetSchema",
+			Handler:    _YoConf_GetSchema_Handler,
+		},
+		{
+			MethodName: "ValidateChunk",
+			Handler:    _YoConf_ValidateChunk_Handler,
+		},
+		{
+			MethodName: "SetRule",
+			Handler:    _YoConf_SetRule_Handler,
+		},
+		{
+			MethodName: "DeleteRule",
+			Handler:    _YoConf_DeleteRule_Handler,
+		},
+		{
+			MethodName: "ListRules",
+			Handler:    _YoConf_ListRules_Handler,
+		},
+		{
+			MethodName: "ListEnvironments",
+			Handler:    _YoConf_ListEnvironments_Handler,
+		},
+		{
+			MethodName: "Promote",
+			Handler:    _YoConf_Promote_Handler,
+		},
+		{
+			MethodName: "Patch",
+			Handler:    _YoConf_Patch_Handler,
+		},
+		{
+			MethodName: "ListDependencies",
+			Handler:    _YoConf_ListDependencies_Handler,
+		},
+		{
+			MethodName: "GetConfig",
+			Handler:    _YoConf_GetConfig_Handler,
+		},
+		{
+			MethodName: "ListSigningKeys",
+			Handler:    _YoConf_ListSigningKeys_Handler,
+		},
+		{
+			MethodName: "StartRollout",
+			Handler:    _YoConf_StartRollout_Handler,
+		},
+		{
+			MethodName: "GetRollout",
+			Handler:    _YoConf_GetRollout_Handler,
+		},
+		{
+			MethodName: "SetRolloutPercent",
+			Handler:    _YoConf_SetRolloutPercent_Handler,
+		},
+		{
+			MethodName: "PromoteRollout",
+			Handler:    _YoConf_PromoteRollout_Handler,
+		},
+		{
+			MethodName: "AbortRollout",
+			Handler:    _YoConf_AbortRollout_Handler,
+		},
+		{
+			MethodName: "SetTargeting",
+			Handler:    _YoConf_SetTargeting_Handler,
+		},
+		{
+			MethodName: "GetTargeting",
+			Handler:    _YoConf_GetTargeting_Handler,
+		},
+		{
+			MethodName: "EvaluateTargeting",
+			Handler:    _YoConf_EvaluateTargeting_Handler,
+		},
+		{
+			MethodName: "ScheduleRollOn",
+			Handler:    _YoConf_ScheduleRollOn_Handler,
+		},
+		{
+			MethodName: "ListSchedules",
+			Handler:    _YoConf_ListSchedules_Handler,
+		},
+		{
+			MethodName: "CancelSchedule",
+			Handler:    _YoConf_CancelSchedule_Handler,
+		},
+		{
+			MethodName: "ListAudit",
+			Handler:    _YoConf_ListAudit_Handler,
+		},
+		{
+			MethodName: "StartOverride",
+			Handler:    _YoConf_StartOverride_Handler,
+		},
+		{
+			MethodName: "ExtendOverride",
+			Handler:    _YoConf_ExtendOverride_Handler,
+		},
+		{
+			MethodName: "EndOverride",
+			Handler:    _YoConf_EndOverride_Handler,
+		},
+		{
+			MethodName: "GetOverride",
+			Handler:    _YoConf_GetOverride_Handler,
+		},
+		{
+			MethodName: "ApproveProposal",
+			Handler:    _YoConf_ApproveProposal_Handler,
+		},
+		{
+			MethodName: "RejectProposal",
+			Handler:    _YoConf_RejectProposal_Handler,
+		},
+		{
+			MethodName: "ListProposals",
+			Handler:    _YoConf_ListProposals_Handler,
+		},
+		{
+			MethodName: "LockProject",
+			Handler:    _YoConf_LockProject_Handler,
+		},
+		{
+			MethodName: "UnlockProject",
+			Handler:    _YoConf_UnlockProject_Handler,
+		},
+		{
+			MethodName: "GetLock",
+			Handler:    _YoConf_GetLock_Handler,
+		},
+		{
+			MethodName: "SetFreezeWindow",
+			Handler:    _YoConf_SetFreezeWindow_Handler,
+		},
+		{
+			MethodName: "DeleteFreezeWindow",
+			Handler:    _YoConf_DeleteFreezeWindow_Handler,
+		},
+		{
+			MethodName: "ListFreezeWindows",
+			Handler:    _YoConf_ListFreezeWindows_Handler,
+		},
+		{
+			MethodName: "EvaluateFlags",
+			Handler:    _YoConf_EvaluateFlags_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/yoconf.proto",
@@ -0,0 +1,175 @@
+package policy
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"github.com/osamikoyo/yoconf/models"
+)
+
+const (
+	ModeEnforce = "enforce"
+	ModeWarn    = "warn"
+)
+
+var ErrInvalidRule = errors.New("invalid rule")
+
+type Input struct {
+	Config   any
+	Previous any
+	Meta     map[string]any
+}
+
+type Result struct {
+	Rule    string `json:"rule"`
+	Mode    string `json:"mode"`
+	Message string `json:"message"`
+}
+
+type Error struct {
+	Failures []Result
+}
+
+func (e *Error) Error() string {
+	messages := make([]string, len(e.Failures))
+	for i, f := range e.Failures {
+		messages[i] = fmt.Sprintf("rule %q failed: %s", f.Rule, f.Message)
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+var (
+	envOnce sync.Once
+	env     *cel.Env
+	envErr  error
+
+	programs sync.Map
+)
+
+func environment() (*cel.Env, error) {
+	envOnce.Do(func() {
+		env, envErr = cel.NewEnv(
+			cel.Variable("config", cel.DynType),
+			cel.Variable("previous", cel.DynType),
+			cel.Variable("meta", cel.MapType(cel.StringType, cel.DynType)),
+		)
+	})
+
+	return env, envErr
+}
+
+func NormalizeMode(mode string) (string, error) {
+	switch strings.ToLower(mode) {
+	case "", ModeEnforce:
+		return ModeEnforce, nil
+	case ModeWarn:
+		return ModeWarn, nil
+	default:
+		return "", fmt.Errorf("%w: unknown mode %q", ErrInvalidRule, mode)
+	}
+}
+
+// Compile type-checks an expression and caches the resulting program.
+// Expressions must evaluate to a bool.
+func Compile(expression string) (cel.Program, error) {
+	if program, ok := programs.Load(expression); ok {
+		return program.(cel.Program), nil
+	}
+
+	e, err := environment()
+	if err != nil {
+		return nil, err
+	}
+
+	ast, issues := e.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRule, issues.Err())
+	}
+
+	if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+		return nil, fmt.Errorf("%w: expression must return bool, got %s", ErrInvalidRule, ast.OutputType())
+	}
+
+	program, err := e.Program(ast)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRule, err)
+	}
+
+	programs.Store(expression, program)
+
+	return program, nil
+}
+
+func evaluate(rule models.Rule, input Input) (bool, string) {
+	program, err := Compile(rule.Expression)
+	if err != nil {
+		return false, err.Error()
+	}
+
+	previous := input.Previous
+	if previous == nil {
+		previous = map[string]any{}
+	}
+
+	out, _, err := program.Eval(map[string]any{
+		"config":   input.Config,
+		"previous": previous,
+		"meta":     input.Meta,
+	})
+	if err != nil {
+		return false, fmt.Sprintf("evaluation error: %v", err)
+	}
+
+	passed, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Sprintf("expression returned %v instead of bool", out.Value())
+	}
+
+	if !passed {
+		if rule.Message != "" {
+			return false, rule.Message
+		}
+
+		return false, rule.Expression
+	}
+
+	return true, ""
+}
+
+// Evaluate runs every rule and splits the failures into blocking errors and
+// warnings. The returned error is nil when no enforced rule failed.
+func Evaluate(rules []models.Rule, input Input) ([]Result, error) {
+	var (
+		warnings []Result
+		failures []Result
+	)
+
+	for _, rule := range rules {
+		passed, message := evaluate(rule, input)
+		if passed {
+			continue
+		}
+
+		result := Result{
+			Rule:    rule.Name,
+			Mode:    rule.Mode,
+			Message: message,
+		}
+
+		if rule.Mode == ModeWarn {
+			warnings = append(warnings, result)
+		} else {
+			failures = append(failures, result)
+		}
+	}
+
+	if len(failures) > 0 {
+		return warnings, &Error{Failures: failures}
+	}
+
+	return warnings, nil
+}
@@ -0,0 +1,91 @@
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+const resource = "schema.json"
+
+var ErrInvalidSchema = errors.New("invalid schema")
+
+type Violation struct {
+	Path    string `json:"path"`
+	Keyword string `json:"keyword"`
+	Message string `json:"message"`
+}
+
+type ValidationError struct {
+	Violations []Violation
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		messages[i] = fmt.Sprintf("%s: %s", v.Path, v.Message)
+	}
+
+	return "schema validation failed: " + strings.Join(messages, "; ")
+}
+
+func Compile(source string) (*jsonschema.Schema, error) {
+	compiler := jsonschema.NewCompiler()
+	if err := compiler.AddResource(resource, strings.NewReader(source)); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSchema, err)
+	}
+
+	compiled, err := compiler.Compile(resource)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSchema, err)
+	}
+
+	return compiled, nil
+}
+
+// Validate checks a parsed config against a schema. Violations are reported
+// per instance path, using only the innermost errors of the validator output.
+func Validate(source string, value any) error {
+	compiled, err := Compile(source)
+	if err != nil {
+		return err
+	}
+
+	err = compiled.Validate(value)
+	if err == nil {
+		return nil
+	}
+
+	var verr *jsonschema.ValidationError
+	if !errors.As(err, &verr) {
+		return err
+	}
+
+	result := &ValidationError{}
+	collect(verr, result)
+
+	return result
+}
+
+func collect(verr *jsonschema.ValidationError, result *ValidationError) {
+	if len(verr.Causes) == 0 {
+		path := verr.InstanceLocation
+		if path == "" {
+			path = "/"
+		}
+
+		result.Violations = append(result.Violations, Violation{
+			Path:    path,
+			Keyword: verr.KeywordLocation,
+			Message: verr.Message,
+		})
+
+		return
+	}
+
+	for _, cause := range verr.Causes {
+		collect(cause, result)
+	}
+}
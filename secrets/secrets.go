@@ -0,0 +1,185 @@
+package secrets
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/osamikoyo/yoconf/keyring"
+)
+
+// Secrets are written as ${secret:plaintext} anywhere in config data and are
+// stored as ${enc:key-id:ciphertext}. The markers are plain text, so they
+// survive every config format as well as layering and includes.
+const Masked = "******"
+
+var (
+	ErrNoKeyProvider = errors.New("no key provider configured")
+	ErrMalformed     = errors.New("malformed secret")
+)
+
+var (
+	plainPattern  = regexp.MustCompile(`\$\{secret:([^}]*)\}`)
+	sealedPattern = regexp.MustCompile(`\$\{enc:([^:}]+):([A-Za-z0-9+/=]+)\}`)
+)
+
+type Sealer struct {
+	keys keyring.KeyProvider
+}
+
+func NewSealer(keys keyring.KeyProvider) *Sealer {
+	return &Sealer{
+		keys: keys,
+	}
+}
+
+func HasSecrets(data string) bool {
+	return strings.Contains(data, "${secret:") || strings.Contains(data, "${enc:")
+}
+
+// Mask replaces every secret, sealed or not, with a fixed placeholder.
+func Mask(data string) string {
+	if !HasSecrets(data) {
+		return data
+	}
+
+	data = plainPattern.ReplaceAllLiteralString(data, Masked)
+
+	return sealedPattern.ReplaceAllLiteralString(data, Masked)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// Plain drops the markers around plaintext secrets, giving the data exactly
+// as a caller allowed to read secrets receives it.
+func Plain(data string) string {
+	if !strings.Contains(data, "${secret:") {
+		return data
+	}
+
+	return plainPattern.ReplaceAllString(data, "$1")
+}
+
+// Seal encrypts the plaintext secrets in data with the current key.
+func (s *Sealer) Seal(data string) (string, error) {
+	if !strings.Contains(data, "${secret:") {
+		return data, nil
+	}
+
+	if s == nil || s.keys == nil {
+		return "", ErrNoKeyProvider
+	}
+
+	id, key, err := s.keys.Current()
+	if err != nil {
+		return "", err
+	}
+
+	aead, err := newGCM(key)
+	if err != nil {
+		return "", err
+	}
+
+	var sealErr error
+
+	sealed := plainPattern.ReplaceAllStringFunc(data, func(match string) string {
+		plaintext := plainPattern.FindStringSubmatch(match)[1]
+
+		nonce := make([]byte, aead.NonceSize())
+		if _, err := rand.Read(nonce); err != nil {
+			sealErr = err
+			return match
+		}
+
+		ciphertext := aead.Seal(nonce, nonce, []byte(plaintext), []byte(id))
+
+		return fmt.Sprintf("${enc:%s:%s}", id, base64.StdEncoding.EncodeToString(ciphertext))
+	})
+	if sealErr != nil {
+		return "", sealErr
+	}
+
+	return sealed, nil
+}
+
+// Reveal decrypts every sealed secret in data back to its plaintext.
+func (s *Sealer) Reveal(data string) (string, error) {
+	return s.reveal(data, func(plaintext string) string {
+		return plaintext
+	})
+}
+
+// Unseal decrypts every sealed secret in data back to a ${secret:plaintext}
+// marker, so that edited data is sealed again when it is published.
+func (s *Sealer) Unseal(data string) (string, error) {
+	return s.reveal(data, func(plaintext string) string {
+		return "${secret:" + plaintext + "}"
+	})
+}
+
+func (s *Sealer) reveal(data string, wrap func(plaintext string) string) (string, error) {
+	if !strings.Contains(data, "${enc:") {
+		return data, nil
+	}
+
+	if s == nil || s.keys == nil {
+		return "", ErrNoKeyProvider
+	}
+
+	var revealErr error
+
+	revealed := sealedPattern.ReplaceAllStringFunc(data, func(match string) string {
+		parts := sealedPattern.FindStringSubmatch(match)
+
+		plaintext, err := s.open(parts[1], parts[2])
+		if err != nil {
+			revealErr = err
+			return match
+		}
+
+		return wrap(plaintext)
+	})
+	if revealErr != nil {
+		return "", revealErr
+	}
+
+	return revealed, nil
+}
+
+func (s *Sealer) open(id, encoded string) (string, error) {
+	key, err := s.keys.Key(id)
+	if err != nil {
+		return "", err
+	}
+
+	aead, err := newGCM(key)
+	if err != nil {
+		return "", err
+	}
+
+	ciphertext, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(ciphertext) < aead.NonceSize() {
+		return "", ErrMalformed
+	}
+
+	nonce, ciphertext := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+
+	plaintext, err := aead.Open(nil, nonce, ciphertext, []byte(id))
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrMalformed, err)
+	}
+
+	return string(plaintext), nil
+}
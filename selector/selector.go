@@ -0,0 +1,303 @@
+// Package selector matches client labels against selectors such as
+//
+//	region=eu, cluster in (blue, green), app_version<2.0, !canary
+//
+// Requirements are separated by commas and must all hold. Supported forms are
+// key=value (or ==), key!=value, key in (a, b), key notin (a, b), key<v,
+// key<=v, key>v, key>=v, key (label is set) and !key (label is not set).
+// Ordering comparisons treat dotted numbers like 1.10.2 as versions and fall
+// back to plain string order otherwise.
+package selector
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidSelector = errors.New("invalid selector")
+
+type operator string
+
+const (
+	opEqual    operator = "="
+	opNotEqual operator = "!="
+	opIn       operator = "in"
+	opNotIn    operator = "notin"
+	opLess     operator = "<"
+	opLessEq   operator = "<="
+	opGreater  operator = ">"
+	opGreatEq  operator = ">="
+	opExists   operator = "exists"
+	opMissing  operator = "!exists"
+)
+
+type requirement struct {
+	key    string
+	op     operator
+	values []string
+}
+
+type Selector struct {
+	requirements []requirement
+}
+
+// splitTopLevel splits on commas that are not inside parentheses.
+func splitTopLevel(s string) ([]string, error) {
+	parts := []string{}
+	depth, last := 0, 0
+
+	for i, r := range s {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("%w: unbalanced parentheses in %q", ErrInvalidSelector, s)
+			}
+		case ',':
+			if depth == 0 {
+				parts = append(parts, s[last:i])
+				last = i + 1
+			}
+		}
+	}
+
+	if depth != 0 {
+		return nil, fmt.Errorf("%w: unbalanced parentheses in %q", ErrInvalidSelector, s)
+	}
+
+	return append(parts, s[last:]), nil
+}
+
+func validKey(key string) bool {
+	if key == "" {
+		return false
+	}
+
+	for _, r := range key {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("._-/", r)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func parseSet(s string) ([]string, bool) {
+	s = strings.TrimSpace(s)
+	if !strings.HasPrefix(s, "(") || !strings.HasSuffix(s, ")") {
+		return nil, false
+	}
+
+	values := []string{}
+	for _, value := range strings.Split(s[1:len(s)-1], ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+
+	return values, len(values) > 0
+}
+
+func parseRequirement(s string) (requirement, error) {
+	s = strings.TrimSpace(s)
+	invalid := fmt.Errorf("%w: %q", ErrInvalidSelector, s)
+
+	if key, ok := strings.CutPrefix(s, "!"); ok {
+		key = strings.TrimSpace(key)
+		if !validKey(key) {
+			return requirement{}, invalid
+		}
+
+		return requirement{key: key, op: opMissing}, nil
+	}
+
+	if fields := strings.Fields(s); len(fields) >= 2 && (fields[1] == "in" || fields[1] == "notin") {
+		rest := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(s[len(fields[0]):]), fields[1]))
+
+		values, ok := parseSet(rest)
+		if !ok || !validKey(fields[0]) {
+			return requirement{}, invalid
+		}
+
+		return requirement{key: fields[0], op: operator(fields[1]), values: values}, nil
+	}
+
+	// Longer operators first, so that <= is not read as <.
+	for _, op := range []string{"==", "!=", "<=", ">=", "=", "<", ">"} {
+		i := strings.Index(s, op)
+		if i < 0 {
+			continue
+		}
+
+		key := strings.TrimSpace(s[:i])
+		value := strings.TrimSpace(s[i+len(op):])
+		if !validKey(key) || value == "" {
+			return requirement{}, invalid
+		}
+
+		if op == "==" {
+			op = "="
+		}
+
+		return requirement{key: key, op: operator(op), values: []string{value}}, nil
+	}
+
+	if !validKey(s) {
+		return requirement{}, invalid
+	}
+
+	return requirement{key: s, op: opExists}, nil
+}
+
+// Parse compiles a selector. The empty selector matches every client.
+func Parse(s string) (Selector, error) {
+	selector := Selector{}
+	if strings.TrimSpace(s) == "" {
+		return selector, nil
+	}
+
+	parts, err := splitTopLevel(s)
+	if err != nil {
+		return selector, err
+	}
+
+	for _, part := range parts {
+		req, err := parseRequirement(part)
+		if err != nil {
+			return selector, err
+		}
+
+		selector.requirements = append(selector.requirements, req)
+	}
+
+	return selector, nil
+}
+
+func (s Selector) Matches(labels map[string]string) bool {
+	for _, req := range s.requirements {
+		if !req.matches(labels) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (r requirement) matches(labels map[string]string) bool {
+	value, ok := labels[r.key]
+
+	switch r.op {
+	case opExists:
+		return ok
+	case opMissing:
+		return !ok
+	case opNotEqual:
+		return !ok || value != r.values[0]
+	case opNotIn:
+		return !ok || !contains(r.values, value)
+	}
+
+	if !ok {
+		return false
+	}
+
+	switch r.op {
+	case opEqual:
+		return value == r.values[0]
+	case opIn:
+		return contains(r.values, value)
+	case opLess:
+		return Compare(value, r.values[0]) < 0
+	case opLessEq:
+		return Compare(value, r.values[0]) <= 0
+	case opGreater:
+		return Compare(value, r.values[0]) > 0
+	case opGreatEq:
+		return Compare(value, r.values[0]) >= 0
+	default:
+		return false
+	}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func versionParts(s string) ([]int, bool) {
+	s = strings.TrimPrefix(s, "v")
+
+	fields := strings.Split(s, ".")
+	parts := make([]int, len(fields))
+
+	for i, field := range fields {
+		n, err := strconv.Atoi(field)
+		if err != nil || n < 0 {
+			return nil, false
+		}
+
+		parts[i] = n
+	}
+
+	return parts, true
+}
+
+// Compare orders two label values, as versions when both look like one.
+func Compare(a, b string) int {
+	av, aok := versionParts(a)
+	bv, bok := versionParts(b)
+
+	if !aok || !bok {
+		return strings.Compare(a, b)
+	}
+
+	for i := 0; i < max(len(av), len(bv)); i++ {
+		var x, y int
+		if i < len(av) {
+			x = av[i]
+		}
+		if i < len(bv) {
+			y = bv[i]
+		}
+
+		if x != y {
+			if x < y {
+				return -1
+			}
+
+			return 1
+		}
+	}
+
+	return 0
+}
+
+// ParseLabels reads labels written as key=value pairs separated by commas.
+func ParseLabels(s string) (map[string]string, error) {
+	labels := map[string]string{}
+
+	for _, pair := range strings.Split(s, ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+
+		key, value, ok := strings.Cut(pair, "=")
+		key = strings.TrimSpace(key)
+		if !ok || !validKey(key) {
+			return nil, fmt.Errorf("%w: label %q", ErrInvalidSelector, pair)
+		}
+
+		labels[key] = strings.TrimSpace(value)
+	}
+
+	return labels, nil
+}
@@ -0,0 +1,111 @@
+package selector
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestMatches(t *testing.T) {
+	labels := map[string]string{
+		"region":      "eu",
+		"cluster":     "blue",
+		"app_version": "1.10.2",
+		"tier":        "b",
+	}
+
+	tests := []struct {
+		selector string
+		want     bool
+	}{
+		{"", true},
+		{"region=eu", true},
+		{"region==eu", true},
+		{"region=us", false},
+		{"region!=us", true},
+		{"zone!=a", true},
+		{"cluster in (blue, green)", true},
+		{"cluster in (red)", false},
+		{"cluster notin (red, green)", true},
+		{"zone notin (a)", true},
+		{"zone in (a)", false},
+		{"app_version<2.0", true},
+		{"app_version>1.9", true},
+		{"app_version>=1.10.2", true},
+		{"app_version<=1.10.1", false},
+		{"v<1", false},
+		{"tier<c", true},
+		{"tier>c", false},
+		{"region", true},
+		{"canary", false},
+		{"!canary", true},
+		{"!region", false},
+		{"region=eu, cluster in (blue, green), app_version<2.0, !canary", true},
+		{"region=eu, canary", false},
+	}
+
+	for _, tt := range tests {
+		s, err := Parse(tt.selector)
+		if err != nil {
+			t.Errorf("Parse(%q) = %v", tt.selector, err)
+			continue
+		}
+
+		if got := s.Matches(labels); got != tt.want {
+			t.Errorf("%q matches = %v, want %v", tt.selector, got, tt.want)
+		}
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, s := range []string{
+		"region=",
+		"=eu",
+		"cluster in blue",
+		"cluster in ()",
+		"cluster in (blue",
+		"a), (b",
+		"!",
+		"re gion",
+		"region=eu,",
+	} {
+		if _, err := Parse(s); !errors.Is(err, ErrInvalidSelector) {
+			t.Errorf("Parse(%q) error = %v, want %v", s, err, ErrInvalidSelector)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.10", "1.9", 1},
+		{"1.2", "1.2.0", 0},
+		{"v2", "1.99", 1},
+		{"1.0-rc1", "1.0", 1},
+		{"abc", "abd", -1},
+	}
+
+	for _, tt := range tests {
+		if got := Compare(tt.a, tt.b); got != tt.want {
+			t.Errorf("Compare(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestParseLabels(t *testing.T) {
+	got, err := ParseLabels(" region = eu, cluster=blue,,empty=")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{"region": "eu", "cluster": "blue", "empty": ""}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseLabels() = %v, want %v", got, want)
+	}
+
+	if _, err = ParseLabels("region"); !errors.Is(err, ErrInvalidSelector) {
+		t.Errorf("ParseLabels(region) error = %v, want %v", err, ErrInvalidSelector)
+	}
+}
@@ -0,0 +1,136 @@
+// Package signing signs published config versions with ed25519 keys.
+//
+// Signing keys come from a keyring whose 32-byte keys are ed25519 seeds. The
+// current key signs new versions, older keys stay listed so that versions
+// signed before a rotation remain verifiable.
+package signing
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/osamikoyo/yoconf/keyring"
+	"github.com/osamikoyo/yoconf/models"
+)
+
+var (
+	ErrUnsigned         = errors.New("chunk is not signed")
+	ErrUnknownKey       = errors.New("unknown signing key")
+	ErrInvalidSignature = errors.New("invalid signature")
+)
+
+type payload struct {
+	Project     string `json:"project"`
+	Environment string `json:"environment"`
+	Version     int    `json:"version"`
+	Format      string `json:"format"`
+	Author      string `json:"author"`
+	Data        string `json:"data"`
+}
+
+// Payload returns the canonical bytes covered by a chunk signature.
+func Payload(chunk *models.Chunk) []byte {
+	data, _ := json.Marshal(payload{
+		Project:     chunk.Project,
+		Environment: models.EnvironmentOrDefault(chunk.Environment),
+		Version:     chunk.Version,
+		Format:      chunk.Format,
+		Author:      chunk.Author,
+		Data:        chunk.Data,
+	})
+
+	return data
+}
+
+type Signer struct {
+	keys keyring.KeyProvider
+}
+
+func NewSigner(keys keyring.KeyProvider) *Signer {
+	return &Signer{
+		keys: keys,
+	}
+}
+
+func (s *Signer) Enabled() bool {
+	return s != nil && s.keys != nil
+}
+
+// Sign stores a signature over chunk in its Signature and SigningKey fields.
+func (s *Signer) Sign(chunk *models.Chunk) error {
+	if !s.Enabled() {
+		return nil
+	}
+
+	id, seed, err := s.keys.Current()
+	if err != nil {
+		return err
+	}
+
+	chunk.SigningKey = id
+	chunk.Signature = base64.StdEncoding.EncodeToString(ed25519.Sign(ed25519.NewKeyFromSeed(seed), Payload(chunk)))
+
+	return nil
+}
+
+func (s *Signer) PublicKeys() ([]models.PublicKey, error) {
+	if !s.Enabled() {
+		return []models.PublicKey{}, nil
+	}
+
+	current, _, err := s.keys.Current()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := s.keys.IDs()
+
+	keys := make([]models.PublicKey, 0, len(ids))
+	for _, id := range ids {
+		seed, err := s.keys.Key(id)
+		if err != nil {
+			return nil, err
+		}
+
+		public := ed25519.NewKeyFromSeed(seed).Public().(ed25519.PublicKey)
+
+		keys = append(keys, models.PublicKey{
+			ID:        id,
+			Algorithm: "ed25519",
+			PublicKey: base64.StdEncoding.EncodeToString(public),
+			Current:   id == current,
+		})
+	}
+
+	return keys, nil
+}
+
+// Verify checks the signature of chunk against a set of published keys.
+func Verify(chunk *models.Chunk, keys []models.PublicKey) error {
+	if chunk.Signature == "" {
+		return ErrUnsigned
+	}
+
+	for _, key := range keys {
+		if key.ID != chunk.SigningKey {
+			continue
+		}
+
+		public, err := base64.StdEncoding.DecodeString(key.PublicKey)
+		if err != nil || len(public) != ed25519.PublicKeySize {
+			return fmt.Errorf("%w: %q", ErrUnknownKey, key.ID)
+		}
+
+		signature, err := base64.StdEncoding.DecodeString(chunk.Signature)
+		if err != nil || !ed25519.Verify(public, Payload(chunk), signature) {
+			return ErrInvalidSignature
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("%w: %q", ErrUnknownKey, chunk.SigningKey)
+}
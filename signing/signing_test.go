@@ -0,0 +1,102 @@
+package signing
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/osamikoyo/yoconf/keyring"
+	"github.com/osamikoyo/yoconf/models"
+)
+
+type staticKeys struct {
+	current string
+	keys    map[string][]byte
+}
+
+func (k *staticKeys) Current() (string, []byte, error) {
+	return k.current, k.keys[k.current], nil
+}
+
+func (k *staticKeys) Key(id string) ([]byte, error) {
+	key, ok := k.keys[id]
+	if !ok {
+		return nil, keyring.ErrUnknownKey
+	}
+
+	return key, nil
+}
+
+func (k *staticKeys) IDs() []string {
+	ids := []string{}
+	for id := range k.keys {
+		ids = append(ids, id)
+	}
+
+	return ids
+}
+
+func TestSignVerify(t *testing.T) {
+	keys := &staticKeys{
+		current: "k1",
+		keys: map[string][]byte{
+			"k1": bytes.Repeat([]byte{1}, keyring.KeySize),
+		},
+	}
+	signer := NewSigner(keys)
+
+	chunk := &models.Chunk{Project: "app", Version: 1, Data: "a: 1\n"}
+	if err := signer.Sign(chunk); err != nil {
+		t.Fatal(err)
+	}
+
+	public, err := signer.PublicKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = Verify(chunk, public); err != nil {
+		t.Fatalf("Verify() = %v", err)
+	}
+
+	// A rotation keeps old signatures verifiable.
+	keys.keys["k2"] = bytes.Repeat([]byte{2}, keyring.KeySize)
+	keys.current = "k2"
+
+	rotated, err := signer.PublicKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = Verify(chunk, rotated); err != nil {
+		t.Fatalf("Verify() after rotation = %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		change func(chunk *models.Chunk)
+		err    error
+	}{
+		{"data", func(c *models.Chunk) { c.Data += "b: 2\n" }, ErrInvalidSignature},
+		{"version", func(c *models.Chunk) { c.Version = 2 }, ErrInvalidSignature},
+		{"environment", func(c *models.Chunk) { c.Environment = "prod" }, ErrInvalidSignature},
+		{"unsigned", func(c *models.Chunk) { c.Signature = "" }, ErrUnsigned},
+		{"unknown key", func(c *models.Chunk) { c.SigningKey = "k9" }, ErrUnknownKey},
+	}
+
+	for _, tt := range tests {
+		changed := *chunk
+		tt.change(&changed)
+
+		if err := Verify(&changed, rotated); !errors.Is(err, tt.err) {
+			t.Errorf("%s: Verify() = %v, want %v", tt.name, err, tt.err)
+		}
+	}
+}
+
+func TestDisabledSigner(t *testing.T) {
+	chunk := &models.Chunk{Project: "app", Version: 1}
+	if err := NewSigner(nil).Sign(chunk); err != nil || chunk.Signature != "" {
+		t.Errorf("Sign() without keys = %v, signature %q", err, chunk.Signature)
+	}
+}
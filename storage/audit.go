@@ -0,0 +1,40 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/osamikoyo/yoconf/models"
+	"go.uber.org/zap"
+)
+
+func (s *Storage) AppendAudit(ctx context.Context, entry *models.AuditEntry) error {
+	res := s.db.WithContext(ctx).Create(entry)
+	if err := res.Error; err != nil {
+		s.logger.Error("failed append audit entry",
+			zap.String("project", entry.Project),
+			zap.String("action", entry.Action),
+			zap.Error(err))
+
+		return fmt.Errorf("failed append audit entry: %v", err)
+	}
+
+	return nil
+}
+
+func (s *Storage) ListAudit(ctx context.Context, project string, limit int) ([]models.AuditEntry, error) {
+	var entries []models.AuditEntry
+
+	res := s.db.WithContext(ctx).Where(&models.AuditEntry{
+		Project: project,
+	}).Order("id desc").Limit(limit).Find(&entries)
+	if err := res.Error; err != nil {
+		s.logger.Error("failed list audit entries",
+			zap.String("project", project),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed list audit entries: %v", err)
+	}
+
+	return entries, nil
+}
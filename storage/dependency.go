@@ -0,0 +1,78 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/osamikoyo/yoconf/models"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// ReplaceDependencies swaps the recorded dependencies of a project environment
+// for the given set.
+func (s *Storage) ReplaceDependencies(ctx context.Context, project, environment string, deps []models.Dependency) error {
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Where(&models.Dependency{
+			Project:     project,
+			Environment: environment,
+		}).Delete(&models.Dependency{})
+		if err := res.Error; err != nil {
+			return err
+		}
+
+		if len(deps) == 0 {
+			return nil
+		}
+
+		return tx.Create(&deps).Error
+	})
+	if err != nil {
+		s.logger.Error("failed replace dependencies",
+			zap.String("project", project),
+			zap.String("environment", environment),
+			zap.Error(err))
+
+		return fmt.Errorf("failed replace dependencies: %v", err)
+	}
+
+	return nil
+}
+
+func (s *Storage) ListDependencies(ctx context.Context, project, environment string) ([]models.Dependency, error) {
+	var deps []models.Dependency
+
+	res := s.db.WithContext(ctx).Where(&models.Dependency{
+		Project:     project,
+		Environment: environment,
+	}).Find(&deps)
+	if err := res.Error; err != nil {
+		s.logger.Error("failed list dependencies",
+			zap.String("project", project),
+			zap.String("environment", environment),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed list dependencies: %v", err)
+	}
+
+	return deps, nil
+}
+
+func (s *Storage) ListDependents(ctx context.Context, project, environment string) ([]models.Dependency, error) {
+	var deps []models.Dependency
+
+	res := s.db.WithContext(ctx).Where(&models.Dependency{
+		TargetProject:     project,
+		TargetEnvironment: environment,
+	}).Find(&deps)
+	if err := res.Error; err != nil {
+		s.logger.Error("failed list dependents",
+			zap.String("project", project),
+			zap.String("environment", environment),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed list dependents: %v", err)
+	}
+
+	return deps, nil
+}
@@ -0,0 +1,139 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/osamikoyo/yoconf/models"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// AcquireLock takes the lock of a project, or renews it for its owner. It
+// reports false while somebody else holds an unexpired lock.
+func (s *Storage) AcquireLock(ctx context.Context, lock *models.Lock, now time.Time) (bool, error) {
+	var acquired bool
+
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Where("project = ? AND (expires_at <= ? OR owner = ?)", lock.Project, now, lock.Owner).
+			Delete(&models.Lock{})
+		if err := res.Error; err != nil {
+			return err
+		}
+
+		res = tx.Clauses(clause.OnConflict{DoNothing: true}).Create(lock)
+		if err := res.Error; err != nil {
+			return err
+		}
+
+		acquired = res.RowsAffected == 1
+
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("failed acquire lock",
+			zap.String("project", lock.Project),
+			zap.String("owner", lock.Owner),
+			zap.Error(err))
+
+		return false, fmt.Errorf("failed acquire lock: %v", err)
+	}
+
+	return acquired, nil
+}
+
+// GetLock returns the unexpired lock of a project.
+func (s *Storage) GetLock(ctx context.Context, project string, now time.Time) (*models.Lock, error) {
+	var lock models.Lock
+
+	res := s.db.WithContext(ctx).
+		Where("project = ? AND expires_at > ?", project, now).
+		First(&lock)
+	if err := res.Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("lock of %q: %w", project, ErrNotFound)
+		}
+
+		s.logger.Error("failed fetch lock",
+			zap.String("project", project),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed fetch lock: %v", err)
+	}
+
+	return &lock, nil
+}
+
+func (s *Storage) DeleteLock(ctx context.Context, project string) error {
+	res := s.db.WithContext(ctx).Where(&models.Lock{
+		Project: project,
+	}).Delete(&models.Lock{})
+	if err := res.Error; err != nil {
+		s.logger.Error("failed delete lock",
+			zap.String("project", project),
+			zap.Error(err))
+
+		return fmt.Errorf("failed delete lock: %v", err)
+	}
+
+	return nil
+}
+
+func (s *Storage) SaveFreezeWindow(ctx context.Context, window *models.FreezeWindow) error {
+	res := s.db.WithContext(ctx).Save(window)
+	if err := res.Error; err != nil {
+		s.logger.Error("failed save freeze window",
+			zap.String("project", window.Project),
+			zap.String("name", window.Name),
+			zap.Error(err))
+
+		return fmt.Errorf("failed save freeze window: %v", err)
+	}
+
+	s.logger.Info("successfully save freeze window",
+		zap.Uint("id", window.ID),
+		zap.String("project", window.Project),
+		zap.String("name", window.Name))
+
+	return nil
+}
+
+func (s *Storage) DeleteFreezeWindow(ctx context.Context, id uint) error {
+	res := s.db.WithContext(ctx).Delete(&models.FreezeWindow{}, id)
+	if err := res.Error; err != nil {
+		s.logger.Error("failed delete freeze window",
+			zap.Uint("id", id),
+			zap.Error(err))
+
+		return fmt.Errorf("failed delete freeze window: %v", err)
+	}
+
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("freeze window %d: %w", id, ErrNotFound)
+	}
+
+	return nil
+}
+
+// ListFreezeWindows returns the windows of a project together with the
+// global ones.
+func (s *Storage) ListFreezeWindows(ctx context.Context, project string) ([]models.FreezeWindow, error) {
+	var windows []models.FreezeWindow
+
+	res := s.db.WithContext(ctx).
+		Where("project = ? OR project = ''", project).
+		Order("id").
+		Find(&windows)
+	if err := res.Error; err != nil {
+		s.logger.Error("failed list freeze windows",
+			zap.String("project", project),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed list freeze windows: %v", err)
+	}
+
+	return windows, nil
+}
@@ -0,0 +1,101 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/osamikoyo/yoconf/envelope"
+	"github.com/osamikoyo/yoconf/models"
+	"go.uber.org/zap"
+)
+
+const RewrapBatchSize = 100
+
+// ListRecordKeys reports the master key protecting every stored chunk.
+func (s *Storage) ListRecordKeys(ctx context.Context) ([]models.RecordKey, error) {
+	var chunks []models.Chunk
+
+	res := s.db.WithContext(ctx).Order("project, environment, version").Find(&chunks)
+	if err := res.Error; err != nil {
+		s.logger.Error("failed list chunks", zap.Error(err))
+
+		return nil, fmt.Errorf("failed list chunks: %v", err)
+	}
+
+	keys := make([]models.RecordKey, len(chunks))
+	for i, chunk := range chunks {
+		keyID, _ := envelope.KeyID(chunk.Data)
+
+		keys[i] = models.RecordKey{
+			Project:     chunk.Project,
+			Environment: chunk.Environment,
+			Version:     chunk.Version,
+			KeyID:       keyID,
+		}
+	}
+
+	return keys, nil
+}
+
+// RewrapChunks moves every stored chunk under the current master key and
+// returns how many records were rewritten.
+func (s *Storage) RewrapChunks(ctx context.Context) (int, error) {
+	if !s.crypter.Enabled() {
+		return 0, envelope.ErrNoMasterKey
+	}
+
+	rewritten := 0
+
+	for offset := 0; ; offset += RewrapBatchSize {
+		var chunks []models.Chunk
+
+		res := s.db.WithContext(ctx).
+			Order("project, environment, version").
+			Limit(RewrapBatchSize).
+			Offset(offset).
+			Find(&chunks)
+		if err := res.Error; err != nil {
+			s.logger.Error("failed list chunks", zap.Error(err))
+
+			return rewritten, fmt.Errorf("failed list chunks: %v", err)
+		}
+
+		for _, chunk := range chunks {
+			data, changed, err := s.crypter.Rewrap(chunk.Data, envelope.Bind(chunk.Project, chunk.Environment, chunk.Version))
+			if err != nil {
+				s.logger.Error("failed rewrap chunk",
+					zap.String("project", chunk.Project),
+					zap.String("environment", chunk.Environment),
+					zap.Int("version", chunk.Version),
+					zap.Error(err))
+
+				return rewritten, fmt.Errorf("failed rewrap chunk: %v", err)
+			}
+
+			if !changed {
+				continue
+			}
+
+			res = s.db.WithContext(ctx).Model(&models.Chunk{}).Where(&models.Chunk{
+				Project:     chunk.Project,
+				Environment: chunk.Environment,
+				Version:     chunk.Version,
+			}).Update("data", data)
+			if err := res.Error; err != nil {
+				s.logger.Error("failed update chunk",
+					zap.String("project", chunk.Project),
+					zap.String("environment", chunk.Environment),
+					zap.Int("version", chunk.Version),
+					zap.Error(err))
+
+				return rewritten, fmt.Errorf("failed update chunk: %v", err)
+			}
+
+			rewritten++
+		}
+
+		if len(chunks) < RewrapBatchSize {
+			return rewritten, nil
+		}
+	}
+}
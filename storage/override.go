@@ -0,0 +1,143 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/osamikoyo/yoconf/models"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+func (s *Storage) SaveOverride(ctx context.Context, override *models.Override) error {
+	res := s.db.WithContext(ctx).Save(override)
+	if err := res.Error; err != nil {
+		s.logger.Error("failed save override",
+			zap.String("project", override.Project),
+			zap.String("environment", override.Environment),
+			zap.Error(err))
+
+		return fmt.Errorf("failed save override: %v", err)
+	}
+
+	s.logger.Info("successfully save override",
+		zap.String("project", override.Project),
+		zap.String("environment", override.Environment),
+		zap.Int("version", override.Version),
+		zap.Time("expires_at", override.ExpiresAt))
+
+	return nil
+}
+
+// StartOverride activates the version of override and stores override in one
+// transaction, so the version never goes live without what reverts it.
+func (s *Storage) StartOverride(ctx context.Context, override *models.Override, pre models.Precondition) error {
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(override).Error; err != nil {
+			return err
+		}
+
+		return activate(tx, override.Project, override.Environment, override.Version, pre)
+	})
+	if errors.Is(err, ErrConflict) {
+		return err
+	}
+
+	if err != nil {
+		s.logger.Error("failed start override",
+			zap.String("project", override.Project),
+			zap.String("environment", override.Environment),
+			zap.Int("version", override.Version),
+			zap.Error(err))
+
+		return fmt.Errorf("failed start override: %v", err)
+	}
+
+	s.logger.Info("successfully start override",
+		zap.String("project", override.Project),
+		zap.String("environment", override.Environment),
+		zap.Int("version", override.Version),
+		zap.Time("expires_at", override.ExpiresAt))
+
+	return nil
+}
+
+func (s *Storage) GetOverride(ctx context.Context, project, environment string) (*models.Override, error) {
+	var override models.Override
+
+	res := s.db.WithContext(ctx).Where(&models.Override{
+		Project:     project,
+		Environment: environment,
+	}).First(&override)
+	if err := res.Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("override of %q in %q: %w", project, environment, ErrNotFound)
+		}
+
+		s.logger.Error("failed fetch override",
+			zap.String("project", project),
+			zap.String("environment", environment),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed fetch override: %v", err)
+	}
+
+	return &override, nil
+}
+
+func (s *Storage) DeleteOverride(ctx context.Context, project, environment string) error {
+	res := s.db.WithContext(ctx).Where(&models.Override{
+		Project:     project,
+		Environment: environment,
+	}).Delete(&models.Override{})
+	if err := res.Error; err != nil {
+		s.logger.Error("failed delete override",
+			zap.String("project", project),
+			zap.String("environment", environment),
+			zap.Error(err))
+
+		return fmt.Errorf("failed delete override: %v", err)
+	}
+
+	return nil
+}
+
+func (s *Storage) ExpiredOverrides(ctx context.Context, now time.Time) ([]models.Override, error) {
+	var overrides []models.Override
+
+	res := s.db.WithContext(ctx).
+		Where("expires_at <= ? AND lease_until < ?", now, now).
+		Order("expires_at").
+		Find(&overrides)
+	if err := res.Error; err != nil {
+		s.logger.Error("failed list expired overrides", zap.Error(err))
+
+		return nil, fmt.Errorf("failed list expired overrides: %v", err)
+	}
+
+	return overrides, nil
+}
+
+// ClaimOverride takes the lease on an expired override, so that only one
+// replica reverts it.
+func (s *Storage) ClaimOverride(ctx context.Context, project, environment, owner string, now time.Time, lease time.Duration) (bool, error) {
+	res := s.db.WithContext(ctx).Model(&models.Override{}).
+		Where("project = ? AND environment = ? AND expires_at <= ? AND lease_until < ?",
+			project, environment, now, now).
+		Updates(map[string]any{
+			"lease_owner": owner,
+			"lease_until": now.Add(lease),
+		})
+	if err := res.Error; err != nil {
+		s.logger.Error("failed claim override",
+			zap.String("project", project),
+			zap.String("environment", environment),
+			zap.Error(err))
+
+		return false, fmt.Errorf("failed claim override: %v", err)
+	}
+
+	return res.RowsAffected == 1, nil
+}
@@ -0,0 +1,53 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/osamikoyo/yoconf/models"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrNotFound = errors.New("not found")
+	ErrConflict = errors.New("conflict")
+)
+
+func (s *Storage) GetProject(ctx context.Context, name string) (*models.Project, error) {
+	var project models.Project
+
+	res := s.db.WithContext(ctx).Where(&models.Project{
+		Name: name,
+	}).First(&project)
+	if err := res.Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("project %q: %w", name, ErrNotFound)
+		}
+
+		s.logger.Error("failed fetch project",
+			zap.String("project", name),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed fetch project: %v", err)
+	}
+
+	return &project, nil
+}
+
+func (s *Storage) SaveProject(ctx context.Context, project *models.Project) error {
+	res := s.db.WithContext(ctx).Save(project)
+	if err := res.Error; err != nil {
+		s.logger.Error("failed save project",
+			zap.String("project", project.Name),
+			zap.Error(err))
+
+		return fmt.Errorf("failed save project: %v", err)
+	}
+
+	s.logger.Info("successfully save project",
+		zap.String("project", project.Name))
+
+	return nil
+}
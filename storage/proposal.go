@@ -0,0 +1,131 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/osamikoyo/yoconf/envelope"
+	"github.com/osamikoyo/yoconf/models"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// CreateProposal stores a pending version next to its proposal without
+// touching the active version.
+func (s *Storage) CreateProposal(ctx context.Context, chunk *models.Chunk, proposal *models.Proposal) error {
+	data, err := s.crypter.Encrypt(chunk.Data, envelope.Bind(chunk.Project, chunk.Environment, chunk.Version))
+	if err != nil {
+		return fmt.Errorf("failed encrypt chunk: %v", err)
+	}
+
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(proposal).Error; err != nil {
+			return err
+		}
+
+		chunk.ProposalID = proposal.ID
+
+		record := *chunk
+		record.Data = data
+		record.InUse = false
+
+		return tx.Create(&record).Error
+	})
+	if err != nil {
+		s.logger.Error("failed create proposal",
+			zap.Any("chunk", chunk),
+			zap.Error(err))
+
+		return fmt.Errorf("failed create proposal: %v", err)
+	}
+
+	s.logger.Info("successfully create proposal",
+		zap.Uint("id", proposal.ID),
+		zap.Any("chunk", chunk))
+
+	return nil
+}
+
+func (s *Storage) GetProposal(ctx context.Context, id uint) (*models.Proposal, error) {
+	var proposal models.Proposal
+
+	res := s.db.WithContext(ctx).First(&proposal, id)
+	if err := res.Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("proposal %d: %w", id, ErrNotFound)
+		}
+
+		s.logger.Error("failed fetch proposal",
+			zap.Uint("id", id),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed fetch proposal: %v", err)
+	}
+
+	return &proposal, nil
+}
+
+func (s *Storage) GetProposalByVersion(ctx context.Context, project, environment string, version int) (*models.Proposal, error) {
+	var proposal models.Proposal
+
+	res := s.db.WithContext(ctx).Where(&models.Proposal{
+		Project:     project,
+		Environment: environment,
+		Version:     version,
+	}).Order("id desc").First(&proposal)
+	if err := res.Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("proposal for %q v%d in %q: %w", project, version, environment, ErrNotFound)
+		}
+
+		s.logger.Error("failed fetch proposal",
+			zap.String("project", project),
+			zap.String("environment", environment),
+			zap.Int("version", version),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed fetch proposal: %v", err)
+	}
+
+	return &proposal, nil
+}
+
+func (s *Storage) ListProposals(ctx context.Context, project, status string) ([]models.Proposal, error) {
+	var proposals []models.Proposal
+
+	res := s.db.WithContext(ctx).Where(&models.Proposal{
+		Project: project,
+		Status:  status,
+	}).Order("id").Find(&proposals)
+	if err := res.Error; err != nil {
+		s.logger.Error("failed list proposals",
+			zap.String("project", project),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed list proposals: %v", err)
+	}
+
+	return proposals, nil
+}
+
+// UpdateProposal saves a reviewed proposal unless someone else updated it
+// since it was read, which it reports as false.
+func (s *Storage) UpdateProposal(ctx context.Context, proposal *models.Proposal) (bool, error) {
+	revision := proposal.Revision
+	proposal.Revision++
+
+	res := s.db.WithContext(ctx).Model(proposal).
+		Where("revision = ?", revision).
+		Select("status", "reviews", "revision").
+		Updates(proposal)
+	if err := res.Error; err != nil {
+		s.logger.Error("failed update proposal",
+			zap.Uint("id", proposal.ID),
+			zap.Error(err))
+
+		return false, fmt.Errorf("failed update proposal: %v", err)
+	}
+
+	return res.RowsAffected == 1, nil
+}
@@ -0,0 +1,95 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/osamikoyo/yoconf/models"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// recordRevision appends the new state of an environment to the global change
+// sequence, inside the transaction that changed it.
+func recordRevision(tx *gorm.DB, project, environment string, version int) error {
+	return tx.Create(&models.Revision{
+		Project:     project,
+		Environment: environment,
+		Version:     version,
+	}).Error
+}
+
+// CurrentRevision returns the latest revision of any environment, or 0 before
+// the first change.
+func (s *Storage) CurrentRevision(ctx context.Context) (int64, error) {
+	var revision int64
+
+	res := s.db.WithContext(ctx).Model(&models.Revision{}).
+		Select("COALESCE(MAX(id), 0)").
+		Scan(&revision)
+	if err := res.Error; err != nil {
+		s.logger.Error("failed fetch current revision", zap.Error(err))
+
+		return 0, fmt.Errorf("failed fetch current revision: %v", err)
+	}
+
+	return revision, nil
+}
+
+// RevisionAt returns the last change of an environment made at or before
+// revision.
+func (s *Storage) RevisionAt(ctx context.Context, project, environment string, revision int64) (*models.Revision, error) {
+	var found models.Revision
+
+	res := s.db.WithContext(ctx).Where(&models.Revision{
+		Project:     project,
+		Environment: environment,
+	}).Where("id <= ?", revision).Order("id DESC").First(&found)
+	if err := res.Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("revision %d of %q in %q: %w", revision, project, environment, ErrNotFound)
+		}
+
+		s.logger.Error("failed fetch revision",
+			zap.String("project", project),
+			zap.String("environment", environment),
+			zap.Int64("revision", revision),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed fetch revision: %v", err)
+	}
+
+	return &found, nil
+}
+
+// FirstRevision returns the first change of an environment.
+func (s *Storage) FirstRevision(ctx context.Context, project, environment string) (*models.Revision, error) {
+	return s.RevisionAfter(ctx, project, environment, 0)
+}
+
+// RevisionAfter returns the first change of an environment made after
+// revision.
+func (s *Storage) RevisionAfter(ctx context.Context, project, environment string, revision int64) (*models.Revision, error) {
+	var found models.Revision
+
+	res := s.db.WithContext(ctx).Where(&models.Revision{
+		Project:     project,
+		Environment: environment,
+	}).Where("id > ?", revision).Order("id").First(&found)
+	if err := res.Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("revision after %d of %q in %q: %w", revision, project, environment, ErrNotFound)
+		}
+
+		s.logger.Error("failed fetch revision",
+			zap.String("project", project),
+			zap.String("environment", environment),
+			zap.Int64("after", revision),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed fetch revision: %v", err)
+	}
+
+	return &found, nil
+}
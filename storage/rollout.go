@@ -0,0 +1,126 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/osamikoyo/yoconf/models"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// SaveRollout stores rollout and leaves its lease alone.
+func (s *Storage) SaveRollout(ctx context.Context, rollout *models.Rollout) error {
+	res := s.db.WithContext(ctx).Omit("lease_owner", "lease_until").Save(rollout)
+	if err := res.Error; err != nil {
+		s.logger.Error("failed save rollout",
+			zap.String("project", rollout.Project),
+			zap.String("environment", rollout.Environment),
+			zap.Error(err))
+
+		return fmt.Errorf("failed save rollout: %v", err)
+	}
+
+	s.logger.Info("successfully save rollout",
+		zap.String("project", rollout.Project),
+		zap.String("environment", rollout.Environment),
+		zap.Int("version", rollout.Version),
+		zap.Int("percent", rollout.Percent))
+
+	return nil
+}
+
+func (s *Storage) GetRollout(ctx context.Context, project, environment string) (*models.Rollout, error) {
+	var rollout models.Rollout
+
+	res := s.db.WithContext(ctx).Where(&models.Rollout{
+		Project:     project,
+		Environment: environment,
+	}).First(&rollout)
+	if err := res.Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("rollout of %q in %q: %w", project, environment, ErrNotFound)
+		}
+
+		s.logger.Error("failed fetch rollout",
+			zap.String("project", project),
+			zap.String("environment", environment),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed fetch rollout: %v", err)
+	}
+
+	return &rollout, nil
+}
+
+func (s *Storage) DeleteRollout(ctx context.Context, project, environment string) error {
+	res := s.db.WithContext(ctx).Where(&models.Rollout{
+		Project:     project,
+		Environment: environment,
+	}).Delete(&models.Rollout{})
+	if err := res.Error; err != nil {
+		s.logger.Error("failed delete rollout",
+			zap.String("project", project),
+			zap.String("environment", environment),
+			zap.Error(err))
+
+		return fmt.Errorf("failed delete rollout: %v", err)
+	}
+
+	return nil
+}
+
+func (s *Storage) ListRollouts(ctx context.Context) ([]models.Rollout, error) {
+	var rollouts []models.Rollout
+
+	res := s.db.WithContext(ctx).Order("project, environment").Find(&rollouts)
+	if err := res.Error; err != nil {
+		s.logger.Error("failed list rollouts", zap.Error(err))
+
+		return nil, fmt.Errorf("failed list rollouts: %v", err)
+	}
+
+	return rollouts, nil
+}
+
+// ClaimRollout takes the lease on a rollout, so only one replica applies its
+// due steps.
+func (s *Storage) ClaimRollout(ctx context.Context, project, environment, owner string, now time.Time, lease time.Duration) (bool, error) {
+	res := s.db.WithContext(ctx).Model(&models.Rollout{}).
+		Where("project = ? AND environment = ? AND (lease_until IS NULL OR lease_until < ?)", project, environment, now).
+		Updates(map[string]any{
+			"lease_owner": owner,
+			"lease_until": now.Add(lease),
+		})
+	if err := res.Error; err != nil {
+		s.logger.Error("failed claim rollout",
+			zap.String("project", project),
+			zap.String("environment", environment),
+			zap.Error(err))
+
+		return false, fmt.Errorf("failed claim rollout: %v", err)
+	}
+
+	return res.RowsAffected == 1, nil
+}
+
+func (s *Storage) ReleaseRollout(ctx context.Context, project, environment, owner string) error {
+	res := s.db.WithContext(ctx).Model(&models.Rollout{}).
+		Where("project = ? AND environment = ? AND lease_owner = ?", project, environment, owner).
+		Updates(map[string]any{
+			"lease_owner": "",
+			"lease_until": time.Time{},
+		})
+	if err := res.Error; err != nil {
+		s.logger.Error("failed release rollout",
+			zap.String("project", project),
+			zap.String("environment", environment),
+			zap.Error(err))
+
+		return fmt.Errorf("failed release rollout: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/osamikoyo/yoconf/models"
+	"go.uber.org/zap"
+)
+
+func (s *Storage) SaveRule(ctx context.Context, rule *models.Rule) error {
+	res := s.db.WithContext(ctx).Save(rule)
+	if err := res.Error; err != nil {
+		s.logger.Error("failed save rule",
+			zap.String("project", rule.Project),
+			zap.String("rule", rule.Name),
+			zap.Error(err))
+
+		return fmt.Errorf("failed save rule: %v", err)
+	}
+
+	s.logger.Info("successfully save rule",
+		zap.String("project", rule.Project),
+		zap.String("rule", rule.Name))
+
+	return nil
+}
+
+func (s *Storage) DeleteRule(ctx context.Context, project, name string) error {
+	res := s.db.WithContext(ctx).Where(&models.Rule{
+		Project: project,
+		Name:    name,
+	}).Delete(&models.Rule{})
+	if err := res.Error; err != nil {
+		s.logger.Error("failed delete rule",
+			zap.String("project", project),
+			zap.String("rule", name),
+			zap.Error(err))
+
+		return fmt.Errorf("failed delete rule: %v", err)
+	}
+
+	s.logger.Info("rule deleted successfully",
+		zap.String("project", project),
+		zap.String("rule", name))
+
+	return nil
+}
+
+func (s *Storage) ListRules(ctx context.Context, project string) ([]models.Rule, error) {
+	var rules []models.Rule
+
+	res := s.db.WithContext(ctx).Where(&models.Rule{
+		Project: project,
+	}).Order("name").Find(&rules)
+	if err := res.Error; err != nil {
+		s.logger.Error("failed list rules",
+			zap.String("project", project),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed list rules: %v", err)
+	}
+
+	return rules, nil
+}
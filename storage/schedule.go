@@ -0,0 +1,182 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/osamikoyo/yoconf/models"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+func (s *Storage) CreateSchedule(ctx context.Context, schedule *models.Schedule) error {
+	res := s.db.WithContext(ctx).Create(schedule)
+	if err := res.Error; err != nil {
+		s.logger.Error("failed create schedule",
+			zap.String("project", schedule.Project),
+			zap.String("environment", schedule.Environment),
+			zap.Int("version", schedule.Version),
+			zap.Error(err))
+
+		return fmt.Errorf("failed create schedule: %v", err)
+	}
+
+	return nil
+}
+
+func (s *Storage) GetSchedule(ctx context.Context, id uint) (*models.Schedule, error) {
+	var schedule models.Schedule
+
+	res := s.db.WithContext(ctx).First(&schedule, id)
+	if err := res.Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("schedule %d: %w", id, ErrNotFound)
+		}
+
+		s.logger.Error("failed fetch schedule",
+			zap.Uint("id", id),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed fetch schedule: %v", err)
+	}
+
+	return &schedule, nil
+}
+
+func (s *Storage) ListSchedules(ctx context.Context, project, environment string) ([]models.Schedule, error) {
+	var schedules []models.Schedule
+
+	res := s.db.WithContext(ctx).Where(&models.Schedule{
+		Project:     project,
+		Environment: environment,
+	}).Order("at").Find(&schedules)
+	if err := res.Error; err != nil {
+		s.logger.Error("failed list schedules",
+			zap.String("project", project),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed list schedules: %v", err)
+	}
+
+	return schedules, nil
+}
+
+// SetScheduleStatus moves a schedule from one status to another and reports
+// whether it was still in the expected status.
+func (s *Storage) SetScheduleStatus(ctx context.Context, id uint, from, to string) (bool, error) {
+	res := s.db.WithContext(ctx).Model(&models.Schedule{}).
+		Where("id = ? AND status = ?", id, from).
+		Update("status", to)
+	if err := res.Error; err != nil {
+		s.logger.Error("failed update schedule",
+			zap.Uint("id", id),
+			zap.Error(err))
+
+		return false, fmt.Errorf("failed update schedule: %v", err)
+	}
+
+	return res.RowsAffected == 1, nil
+}
+
+// DueSchedules lists pending schedules whose time has come together with
+// running ones whose lease ran out, e.g. because their replica died.
+func (s *Storage) DueSchedules(ctx context.Context, now time.Time) ([]models.Schedule, error) {
+	var schedules []models.Schedule
+
+	res := s.db.WithContext(ctx).
+		Where("(status = ? AND at <= ?) OR (status = ? AND lease_until < ?)",
+			models.SchedulePending, now, models.ScheduleRunning, now).
+		Order("at").
+		Find(&schedules)
+	if err := res.Error; err != nil {
+		s.logger.Error("failed list due schedules", zap.Error(err))
+
+		return nil, fmt.Errorf("failed list due schedules: %v", err)
+	}
+
+	return schedules, nil
+}
+
+// ClaimSchedule takes the lease on a due schedule. The conditional update is
+// atomic, so when several replicas race for a schedule only one wins.
+func (s *Storage) ClaimSchedule(ctx context.Context, id uint, owner string, now time.Time, lease time.Duration) (bool, error) {
+	res := s.db.WithContext(ctx).Model(&models.Schedule{}).
+		Where("id = ? AND ((status = ? AND at <= ?) OR (status = ? AND lease_until < ?))",
+			id, models.SchedulePending, now, models.ScheduleRunning, now).
+		Updates(map[string]any{
+			"status":      models.ScheduleRunning,
+			"lease_owner": owner,
+			"lease_until": now.Add(lease),
+		})
+	if err := res.Error; err != nil {
+		s.logger.Error("failed claim schedule",
+			zap.Uint("id", id),
+			zap.Error(err))
+
+		return false, fmt.Errorf("failed claim schedule: %v", err)
+	}
+
+	return res.RowsAffected == 1, nil
+}
+
+// RunSchedule activates the version of a claimed schedule and marks it done
+// in one transaction, so a replica whose lease ran out cannot activate it a
+// second time.
+func (s *Storage) RunSchedule(ctx context.Context, schedule *models.Schedule, owner string) error {
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now().UTC()
+
+		res := tx.Model(&models.Schedule{}).
+			Where("id = ? AND lease_owner = ? AND status = ?", schedule.ID, owner, models.ScheduleRunning).
+			Updates(map[string]any{
+				"status":      models.ScheduleDone,
+				"error":       "",
+				"finished_at": &now,
+			})
+		if err := res.Error; err != nil {
+			return err
+		}
+
+		if res.RowsAffected == 0 {
+			return fmt.Errorf("schedule %d lease lost: %w", schedule.ID, ErrConflict)
+		}
+
+		return activate(tx, schedule.Project, schedule.Environment, schedule.Version, models.Precondition{})
+	})
+	if errors.Is(err, ErrConflict) {
+		return err
+	}
+
+	if err != nil {
+		s.logger.Error("failed run schedule",
+			zap.Uint("id", schedule.ID),
+			zap.Error(err))
+
+		return fmt.Errorf("failed run schedule: %v", err)
+	}
+
+	return nil
+}
+
+func (s *Storage) FinishSchedule(ctx context.Context, id uint, owner, status, message string) error {
+	now := time.Now().UTC()
+
+	res := s.db.WithContext(ctx).Model(&models.Schedule{}).
+		Where("id = ? AND lease_owner = ?", id, owner).
+		Updates(map[string]any{
+			"status":      status,
+			"error":       message,
+			"finished_at": &now,
+		})
+	if err := res.Error; err != nil {
+		s.logger.Error("failed finish schedule",
+			zap.Uint("id", id),
+			zap.Error(err))
+
+		return fmt.Errorf("failed finish schedule: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/osamikoyo/yoconf/models"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+func (s *Storage) CreateSchema(ctx context.Context, schema *models.Schema) error {
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var last int
+
+		res := tx.Model(&models.Schema{}).
+			Where(&models.Schema{Project: schema.Project}).
+			Select("COALESCE(MAX(version), 0)").
+			Scan(&last)
+		if err := res.Error; err != nil {
+			return err
+		}
+
+		schema.Version = last + 1
+
+		return tx.Create(schema).Error
+	})
+	if err != nil {
+		s.logger.Error("failed create schema",
+			zap.String("project", schema.Project),
+			zap.Error(err))
+
+		return fmt.Errorf("failed create schema: %v", err)
+	}
+
+	s.logger.Info("successfully create schema",
+		zap.String("project", schema.Project),
+		zap.Int("version", schema.Version))
+
+	return nil
+}
+
+func (s *Storage) GetSchema(ctx context.Context, project string, version int) (*models.Schema, error) {
+	var schema models.Schema
+
+	res := s.db.WithContext(ctx).Where(&models.Schema{
+		Project: project,
+		Version: version,
+	}).First(&schema)
+	if err := res.Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("schema %q v%d: %w", project, version, ErrNotFound)
+		}
+
+		s.logger.Error("failed fetch schema",
+			zap.String("project", project),
+			zap.Int("version", version),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed fetch schema: %v", err)
+	}
+
+	return &schema, nil
+}
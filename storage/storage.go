@@ -2,9 +2,11 @@ package storage
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/osamikoyo/yoconf/config"
+	"github.com/osamikoyo/yoconf/envelope"
 	"github.com/osamikoyo/yoconf/logger"
 	"github.com/osamikoyo/yoconf/models"
 	"go.uber.org/zap"
@@ -12,34 +14,153 @@ import (
 )
 
 type Storage struct {
-	logger *logger.Logger
-	cfg    *config.Config
-	db     *gorm.DB
+	logger  *logger.Logger
+	cfg     *config.Config
+	db      *gorm.DB
+	crypter *envelope.Crypter
 }
 
-func NewStorage(db *gorm.DB, logger *logger.Logger, cfg *config.Config) *Storage {
+func NewStorage(db *gorm.DB, logger *logger.Logger, cfg *config.Config, crypter *envelope.Crypter) *Storage {
 	return &Storage{
-		logger: logger,
-		cfg:    cfg,
-		db:     db,
+		logger:  logger,
+		cfg:     cfg,
+		db:      db,
+		crypter: crypter,
 	}
 }
 
-func (s *Storage) CreateNewChunk(ctx context.Context, chunk *models.Chunk) error {
-	res := s.db.WithContext(ctx).Where(&models.Chunk{
-		InUse:   true,
-		Project: chunk.Project,
-	}).Updates(&models.Chunk{InUse: false})
+// open decrypts chunk data read from the database in place.
+func (s *Storage) open(chunk *models.Chunk) error {
+	data, err := s.crypter.Decrypt(chunk.Data, envelope.Bind(chunk.Project, chunk.Environment, chunk.Version))
+	if err != nil {
+		s.logger.Error("failed decrypt chunk",
+			zap.String("project", chunk.Project),
+			zap.String("environment", chunk.Environment),
+			zap.Int("version", chunk.Version),
+			zap.Error(err))
+
+		return fmt.Errorf("failed decrypt chunk: %v", err)
+	}
+
+	chunk.Data = data
+
+	return nil
+}
+
+// current selects the active version of an environment if it satisfies pre.
+func current(tx *gorm.DB, project, environment string, pre models.Precondition) *gorm.DB {
+	query := tx.Model(&models.Chunk{}).Where(&models.Chunk{
+		InUse:       true,
+		Project:     project,
+		Environment: environment,
+	})
+
+	if pre.Version != 0 {
+		query = query.Where("version = ?", pre.Version)
+	}
+
+	if pre.Hash != "" {
+		query = query.Where("hash = ?", pre.Hash)
+	}
+
+	return query
+}
+
+func conflict(project, environment string) error {
+	return fmt.Errorf("active version of %q in %q changed: %w", project, environment, ErrConflict)
+}
+
+// check fails with ErrConflict when the active version of an environment no
+// longer satisfies pre.
+func check(tx *gorm.DB, project, environment string, pre models.Precondition) error {
+	if pre.IsZero() {
+		return nil
+	}
+
+	var count int64
+	if err := current(tx, project, environment, pre).Count(&count).Error; err != nil {
+		return err
+	}
+
+	if count == 0 {
+		return conflict(project, environment)
+	}
+
+	return nil
+}
+
+// deactivate clears the active version of an environment, failing with
+// ErrConflict when it no longer satisfies pre.
+func deactivate(tx *gorm.DB, project, environment string, pre models.Precondition) error {
+	res := current(tx, project, environment, pre).Update("in_use", false)
 	if err := res.Error; err != nil {
-		s.logger.Error("failed update old chunk",
+		return err
+	}
+
+	if !pre.IsZero() && res.RowsAffected == 0 {
+		return conflict(project, environment)
+	}
+
+	return nil
+}
+
+// activate makes version the active one of its environment.
+func activate(tx *gorm.DB, project, environment string, version int, pre models.Precondition) error {
+	if err := deactivate(tx, project, environment, pre); err != nil {
+		return err
+	}
+
+	err := tx.Model(&models.Chunk{}).Where(&models.Chunk{
+		Project:     project,
+		Environment: environment,
+		Version:     version,
+	}).Update("in_use", true).Error
+	if err != nil {
+		return err
+	}
+
+	return recordRevision(tx, project, environment, version)
+}
+
+func (s *Storage) CreateNewChunk(ctx context.Context, chunk *models.Chunk, pre models.Precondition) error {
+	data, err := s.crypter.Encrypt(chunk.Data, envelope.Bind(chunk.Project, chunk.Environment, chunk.Version))
+	if err != nil {
+		s.logger.Error("failed encrypt chunk",
 			zap.Any("chunk", chunk),
 			zap.Error(err))
 
-		return fmt.Errorf("failed update old chunk: %v", err)
+		return fmt.Errorf("failed encrypt chunk: %v", err)
 	}
 
-	res = s.db.WithContext(ctx).Create(chunk)
-	if err := res.Error; err != nil {
+	record := *chunk
+	record.Data = data
+
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// An inactive version is published ahead of its activation and
+		// leaves the active one alone.
+		if !chunk.InUse {
+			if err := check(tx, chunk.Project, chunk.Environment, pre); err != nil {
+				return err
+			}
+
+			return tx.Create(&record).Error
+		}
+
+		if err := deactivate(tx, chunk.Project, chunk.Environment, pre); err != nil {
+			return err
+		}
+
+		if err := tx.Create(&record).Error; err != nil {
+			return err
+		}
+
+		return recordRevision(tx, chunk.Project, chunk.Environment, chunk.Version)
+	})
+	if errors.Is(err, ErrConflict) {
+		return err
+	}
+
+	if err != nil {
 		s.logger.Error("failed create new chunk",
 			zap.Any("chunk", chunk),
 			zap.Error(err))
@@ -52,21 +173,60 @@ func (s *Storage) CreateNewChunk(ctx context.Context, chunk *models.Chunk) error
 	return nil
 }
 
-func (s *Storage) GetChunk(ctx context.Context, project string) (*models.Chunk, error) {
+func (s *Storage) GetChunk(ctx context.Context, project, environment string) (*models.Chunk, error) {
 	var chunk models.Chunk
 
 	res := s.db.WithContext(ctx).Where(&models.Chunk{
-		InUse:   true,
-		Project: project,
+		InUse:       true,
+		Project:     project,
+		Environment: environment,
 	}).First(&chunk)
 	if err := res.Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("chunk %q in %q: %w", project, environment, ErrNotFound)
+		}
+
 		s.logger.Error("failed fetch chunk",
 			zap.String("project", project),
+			zap.String("environment", environment),
 			zap.Error(err))
 
 		return nil, fmt.Errorf("failed fetch chunk: %v", err)
 	}
 
+	if err := s.open(&chunk); err != nil {
+		return nil, err
+	}
+
+	return &chunk, nil
+}
+
+func (s *Storage) GetChunkByVersion(ctx context.Context, project, environment string, version int) (*models.Chunk, error) {
+	var chunk models.Chunk
+
+	res := s.db.WithContext(ctx).Where(&models.Chunk{
+		Project:     project,
+		Environment: environment,
+		Version:     version,
+	}).First(&chunk)
+	if err := res.Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("chunk %q v%d in %q: %w", project, version, environment, ErrNotFound)
+		}
+
+		s.logger.Error("failed fetch chunk",
+			zap.String("project", project),
+			zap.String("environment", environment),
+			zap.Int("version", version),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed fetch chunk: %v", err)
+	}
+
+	if err := s.open(&chunk); err != nil {
+		return nil, err
+	}
+
 	return &chunk, nil
 }
 
@@ -100,15 +260,53 @@ func (s *Storage) ListProjects(ctx context.Context) ([]string, error) {
 	return unique(projects), nil
 }
 
-func (s *Storage) ListVersions(ctx context.Context, project string) ([]int, error) {
+func (s *Storage) ListEnvironments(ctx context.Context, project string) ([]string, error) {
+	var environments []string
+
+	res := s.db.WithContext(ctx).Model(&models.Chunk{}).Where(&models.Chunk{
+		Project: project,
+	}).Distinct("environment").Order("environment").Pluck("environment", &environments)
+	if err := res.Error; err != nil {
+		s.logger.Error("failed list environments",
+			zap.String("project", project),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed list environments: %v", err)
+	}
+
+	return environments, nil
+}
+
+func (s *Storage) LatestVersion(ctx context.Context, project, environment string) (int, error) {
+	var latest int
+
+	res := s.db.WithContext(ctx).Model(&models.Chunk{}).Where(&models.Chunk{
+		Project:     project,
+		Environment: environment,
+	}).Select("COALESCE(MAX(version), 0)").Scan(&latest)
+	if err := res.Error; err != nil {
+		s.logger.Error("failed fetch latest version",
+			zap.String("project", project),
+			zap.String("environment", environment),
+			zap.Error(err))
+
+		return 0, fmt.Errorf("failed fetch latest version: %v", err)
+	}
+
+	return latest, nil
+}
+
+func (s *Storage) ListVersions(ctx context.Context, project, environment string) ([]int, error) {
 	var versions []models.Chunk
 
 	res := s.db.WithContext(ctx).Where(&models.Chunk{
-		Project: project,
+		Project:     project,
+		Environment: environment,
 	}).Find(&versions)
 	if err := res.Error; err != nil {
 		s.logger.Error("failed find chunks",
 			zap.String("project", project),
+			zap.String("environment", environment),
 			zap.Error(err))
 
 		return nil, fmt.Errorf("failed find chunks: %v", err)
@@ -120,53 +318,65 @@ func (s *Storage) ListVersions(ctx context.Context, project string) ([]int, erro
 	}
 
 	s.logger.Info("fetched versions",
-		zap.String("project", project))
+		zap.String("project", project),
+		zap.String("environment", environment))
 
 	return resp, nil
 }
 
-func (s *Storage) RollChunkOn(ctx context.Context, project string, version int) error {
-	res := s.db.WithContext(ctx).Where(&models.Chunk{
-		Project: project,
-		InUse:   true,
-	}).Update("in_use", false)
-	if err := res.Error; err != nil {
-		s.logger.Error("failed to update old version",
-			zap.String("project", project),
-			zap.Int("version", version),
-			zap.Error(err))
-
-		return fmt.Errorf("failed to update old version: %v", err)
+func (s *Storage) RollChunkOn(ctx context.Context, project, environment string, version int, pre models.Precondition) error {
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return activate(tx, project, environment, version, pre)
+	})
+	if errors.Is(err, ErrConflict) {
+		return err
 	}
 
-	res = s.db.WithContext(ctx).Where(&models.Chunk{
-		Project: project,
-		Version: version,
-	}).Update("in_use", true)
-	if err := res.Error; err != nil {
+	if err != nil {
 		s.logger.Error("failed roll chunk on",
 			zap.String("project", project),
+			zap.String("environment", environment),
 			zap.Int("version", version),
 			zap.Error(err))
 
-		return fmt.Errorf("failed to update new version: %v", err)
+		return fmt.Errorf("failed roll chunk on: %v", err)
 	}
 
 	s.logger.Info("successfully roll chunk on",
 		zap.String("project", project),
+		zap.String("environment", environment),
 		zap.Int("version", version))
 
 	return nil
 }
 
-func (s *Storage) DeleteConfig(ctx context.Context, project string, version int) error {
-	res := s.db.WithContext(ctx).Where(&models.Chunk{
-		Project: project,
-		Version: version,
-	}).Delete(&models.Chunk{})
-	if err := res.Error; err != nil {
+func (s *Storage) DeleteConfig(ctx context.Context, project, environment string, version int) error {
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Where(&models.Chunk{
+			Project:     project,
+			Environment: environment,
+			Version:     version,
+			InUse:       true,
+		}).Delete(&models.Chunk{})
+		if err := res.Error; err != nil {
+			return err
+		}
+
+		// Deleting the active version leaves the environment without one.
+		if res.RowsAffected > 0 {
+			return recordRevision(tx, project, environment, 0)
+		}
+
+		return tx.Where(&models.Chunk{
+			Project:     project,
+			Environment: environment,
+			Version:     version,
+		}).Delete(&models.Chunk{}).Error
+	})
+	if err != nil {
 		s.logger.Error("failed delete chunk",
 			zap.String("project", project),
+			zap.String("environment", environment),
 			zap.Int("version", version),
 			zap.Error(err))
 
@@ -175,6 +385,7 @@ func (s *Storage) DeleteConfig(ctx context.Context, project string, version int)
 
 	s.logger.Info("chunk deleted successfully",
 		zap.String("project", project),
+		zap.String("environment", environment),
 		zap.Int("version", version))
 
 	return nil
@@ -0,0 +1,82 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/osamikoyo/yoconf/logger"
+	"github.com/osamikoyo/yoconf/models"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func newStorage(t *testing.T) *Storage {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open("file::memory:"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Every connection to :memory: opens a database of its own.
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	if err = db.AutoMigrate(&models.Chunk{}, &models.Revision{}); err != nil {
+		t.Fatal(err)
+	}
+
+	return NewStorage(db, logger.Get(), nil, nil)
+}
+
+func TestCreateInactiveChunk(t *testing.T) {
+	s := newStorage(t)
+	ctx := context.Background()
+
+	active := &models.Chunk{Project: "p", Environment: "default", Version: 1, Data: "a: 1\n", InUse: true}
+	if err := s.CreateNewChunk(ctx, active, models.Precondition{}); err != nil {
+		t.Fatal(err)
+	}
+
+	revision, err := s.CurrentRevision(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ahead := &models.Chunk{Project: "p", Environment: "default", Version: 2, Data: "a: 2\n"}
+	if err = s.CreateNewChunk(ctx, ahead, models.Precondition{Version: 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := s.GetChunk(ctx, "p", "default")
+	if err != nil || got.Version != 1 {
+		t.Fatalf("GetChunk() after an inactive publish = %+v, %v, want version 1", got, err)
+	}
+
+	if after, _ := s.CurrentRevision(ctx); after != revision {
+		t.Errorf("CurrentRevision() after an inactive publish = %d, want %d", after, revision)
+	}
+
+	stale := &models.Chunk{Project: "p", Environment: "default", Version: 3, Data: "a: 3\n"}
+	if err = s.CreateNewChunk(ctx, stale, models.Precondition{Version: 2}); !errors.Is(err, ErrConflict) {
+		t.Errorf("CreateNewChunk() with a stale precondition = %v, want %v", err, ErrConflict)
+	}
+
+	if _, err = s.GetChunkByVersion(ctx, "p", "default", 3); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetChunkByVersion(3) after a conflict = %v, want %v", err, ErrNotFound)
+	}
+
+	if err = s.RollChunkOn(ctx, "p", "default", 2, models.Precondition{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, err = s.GetChunk(ctx, "p", "default"); err != nil || got.Version != 2 || got.Data != "a: 2\n" {
+		t.Errorf("GetChunk() after roll on = %+v, %v, want version 2", got, err)
+	}
+}
@@ -0,0 +1,53 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/osamikoyo/yoconf/models"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+func (s *Storage) SaveTargeting(ctx context.Context, targeting *models.Targeting) error {
+	res := s.db.WithContext(ctx).Save(targeting)
+	if err := res.Error; err != nil {
+		s.logger.Error("failed save targeting",
+			zap.String("project", targeting.Project),
+			zap.String("environment", targeting.Environment),
+			zap.Error(err))
+
+		return fmt.Errorf("failed save targeting: %v", err)
+	}
+
+	s.logger.Info("successfully save targeting",
+		zap.String("project", targeting.Project),
+		zap.String("environment", targeting.Environment),
+		zap.Int("rules", len(targeting.Rules)))
+
+	return nil
+}
+
+func (s *Storage) GetTargeting(ctx context.Context, project, environment string) (*models.Targeting, error) {
+	var targeting models.Targeting
+
+	res := s.db.WithContext(ctx).Where(&models.Targeting{
+		Project:     project,
+		Environment: environment,
+	}).First(&targeting)
+	if err := res.Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("targeting of %q in %q: %w", project, environment, ErrNotFound)
+		}
+
+		s.logger.Error("failed fetch targeting",
+			zap.String("project", project),
+			zap.String("environment", environment),
+			zap.Error(err))
+
+		return nil, fmt.Errorf("failed fetch targeting: %v", err)
+	}
+
+	return &targeting, nil
+}